@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// typeSignature describes the layout of t so that caches written by a
+// build with a different model are not reused.
+func typeSignature(t reflect.Type, w io.Writer, seen map[reflect.Type]bool) {
+	switch t.Kind() {
+	case reflect.Struct:
+		if seen[t] {
+			fmt.Fprint(w, t.Name())
+			return
+		}
+		seen[t] = true
+		fmt.Fprintf(w, "%s{", t.Name())
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			fmt.Fprintf(w, "%s %q ", f.Name, f.Tag)
+			typeSignature(f.Type, w, seen)
+			fmt.Fprint(w, ";")
+		}
+		fmt.Fprint(w, "}")
+	case reflect.Slice, reflect.Ptr:
+		fmt.Fprint(w, t.Kind(), " ")
+		typeSignature(t.Elem(), w, seen)
+	default:
+		fmt.Fprint(w, t.Kind())
+	}
+}
+
+// specHash hashes the contents of every XML file in dir along with the
+// layout of the parsed model.
+func specHash(dir string) (string, error) {
+	h := sha256.New()
+	typeSignature(reflect.TypeOf(SpecFile{}), h, make(map[reflect.Type]bool))
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".xml") {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		fmt.Fprintf(h, "\x00%s\x00", filepath.ToSlash(rel))
+		_, err = io.Copy(h, f)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func cachePath(hash string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "armgen", hash+".gob"), nil
+}
+
+func readCache(path string) ([]SpecFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var files []SpecFile
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&files); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return files, nil
+}
+
+func writeCache(path string, files []SpecFile) error {
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(files); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// LoadSpec loads the spec in dir, using the cache built by a previous run if
+// the spec has not changed. Setting ARMGEN_NOCACHE disables the cache.
+func LoadSpec(dir string) (*Spec, error) {
+	if os.Getenv("ARMGEN_NOCACHE") != "" {
+		return ParseSpec(dir)
+	}
+	hash, err := specHash(dir)
+	if err != nil {
+		return nil, err
+	}
+	path, err := cachePath(hash)
+	if err != nil {
+		return ParseSpec(dir)
+	}
+	if files, err := readCache(path); err == nil {
+		return &Spec{Dir: dir, Files: files}, nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		fmt.Fprintf(os.Stderr, "armgen: ignoring cache: %v\n", err)
+	}
+	spec, err := ParseSpec(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeCache(path, spec.Files); err != nil {
+		fmt.Fprintf(os.Stderr, "armgen: writing cache: %v\n", err)
+	}
+	return spec, nil
+}
+
+var indexCmd = &command{
+	name:  "index",
+	args:  "SPECDIR",
+	short: "parse the spec and store it in the cache used by the other commands",
+	run:   runIndex,
+}
+
+func runIndex(fs *flag.FlagSet, args []string) error {
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	dir, err := specArg(fs)
+	if err != nil {
+		return err
+	}
+	hash, err := specHash(dir)
+	if err != nil {
+		return err
+	}
+	path, err := cachePath(hash)
+	if err != nil {
+		return err
+	}
+	spec, err := ParseSpec(dir)
+	if err != nil {
+		return err
+	}
+	if err := writeCache(path, spec.Files); err != nil {
+		return err
+	}
+	fmt.Printf("%s: %d files\n", path, len(spec.Files))
+	return nil
+}
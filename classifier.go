@@ -0,0 +1,111 @@
+package main
+
+import "math/bits"
+
+// A Classifier maps each word to the first record whose diagram matches it.
+// Its matchers are built at run time from the records, so a table is always
+// classified with the records it is checked against.
+//
+// Rather than trying every pattern on every word, it keeps, for each byte of
+// the word and each value of that byte, the set of records whose fixed bits
+// in that byte agree with it. The records that can match a word are the
+// intersection of the sets of its four bytes, and the sets of the upper
+// bytes are intersected once for a whole page or block of words, so each
+// word only costs an intersection with the few candidates left.
+type Classifier struct {
+	patterns []Pattern
+	n        int // words per bitset
+	sets     [4][256][]uint64
+}
+
+func NewClassifier(records []Record) (*Classifier, error) {
+	ps, err := recordPatterns(records)
+	if err != nil {
+		return nil, err
+	}
+	return newClassifier(ps), nil
+}
+
+func newClassifier(ps []Pattern) *Classifier {
+	c := &Classifier{patterns: ps, n: (len(ps) + 63) / 64}
+	buf := make([]uint64, 4*256*c.n)
+	for k := range c.sets {
+		shift := uint(8 * k)
+		for b := range c.sets[k] {
+			set := buf[:c.n:c.n]
+			buf = buf[c.n:]
+			for i, p := range ps {
+				m, v := p.Mask>>shift&0xff, p.Value>>shift&0xff
+				if uint32(b)&m == v {
+					set[i/64] |= 1 << uint(i%64)
+				}
+			}
+			c.sets[k][b] = set
+		}
+	}
+	return c
+}
+
+// intersect stores the intersection of a and b, over the words listed in
+// live, in dst and returns the words of dst that are not empty.
+func intersect(dst, a, b []uint64, live, out []int) []int {
+	out = out[:0]
+	for _, i := range live {
+		if dst[i] = a[i] & b[i]; dst[i] != 0 {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+// first returns the first candidate in set, over the words listed in live,
+// that matches w once its constraints are checked.
+func (c *Classifier) first(set []uint64, live []int, low []uint64, w uint32) int32 {
+	for _, i := range live {
+		for x := set[i] & low[i]; x != 0; x &= x - 1 {
+			r := i*64 + bits.TrailingZeros64(x)
+			if p := &c.patterns[r]; len(p.Excludes) == 0 || !p.Excluded(w) {
+				return int32(r)
+			}
+		}
+	}
+	return -1
+}
+
+// Classify returns the index of the record matching w, or -1.
+func (c *Classifier) Classify(w uint32) int32 {
+	set := make([]uint64, c.n)
+	all := make([]int, c.n)
+	for i := range all {
+		all[i] = i
+	}
+	live := intersect(set, c.sets[3][w>>24], c.sets[2][w>>16&0xff], all, nil)
+	live = intersect(set, set, c.sets[1][w>>8&0xff], live, nil)
+	return c.first(set, live, c.sets[0][w&0xff], w)
+}
+
+// ClassifyPage classifies the PageSize words starting at base, which must be
+// a multiple of PageSize, into vals.
+func (c *Classifier) ClassifyPage(base uint32, vals []int32) {
+	page, block := make([]uint64, c.n), make([]uint64, c.n)
+	all := make([]int, c.n)
+	for i := range all {
+		all[i] = i
+	}
+	pageLive := intersect(page, c.sets[3][base>>24], c.sets[2][base>>16&0xff], all, nil)
+	var blockLive []int
+	for b1 := 0; b1 < 256; b1++ {
+		out := vals[b1*256 : (b1+1)*256]
+		blockLive = intersect(block, page, c.sets[1][b1], pageLive, blockLive)
+		if len(blockLive) == 0 {
+			for i := range out {
+				out[i] = -1
+			}
+			continue
+		}
+		w := base | uint32(b1)<<8
+		for b0 := range out {
+			out[b0] = c.first(block, blockLive, c.sets[0][b0], w|uint32(b0))
+		}
+	}
+}
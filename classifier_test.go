@@ -0,0 +1,44 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestClassifier(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var ps []Pattern
+	for i := 0; i < 150; i++ {
+		mask := r.Uint32() & r.Uint32() & r.Uint32()
+		p := Pattern{Mask: mask | 0xfff00000, Value: r.Uint32() & (mask | 0xfff00000)}
+		if i%3 == 0 {
+			em := uint32(0x1f) << uint(r.Intn(5)*5)
+			p.Excludes = []Exclude{{em, r.Uint32() & em}}
+		}
+		ps = append(ps, p)
+	}
+	c := newClassifier(ps)
+	want := func(w uint32) int32 {
+		for i, p := range ps {
+			if p.Match(w) {
+				return int32(i)
+			}
+		}
+		return -1
+	}
+	vals := make([]int32, PageSize)
+	for n := 0; n < 40; n++ {
+		p := ps[r.Intn(len(ps))]
+		base := (p.Value | r.Uint32()&^p.Mask) &^ (PageSize - 1)
+		c.ClassifyPage(base, vals)
+		for i, v := range vals {
+			w := base | uint32(i)
+			if got := want(w); v != got {
+				t.Fatalf("ClassifyPage: %08x is %d, want %d", w, v, got)
+			}
+			if i%97 == 0 && c.Classify(w) != v {
+				t.Fatalf("Classify: %08x is %d, want %d", w, c.Classify(w), v)
+			}
+		}
+	}
+}
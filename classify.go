@@ -0,0 +1,254 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var classifyCmd = &command{
+	name:  "classify",
+	args:  "(-out TABLE | -in TABLE | -stats | -holes | -overlaps | -png FILE | -words) (RECORDS.json | SPECDIR) [FILE...]",
+	short: "classify the 32-bit encoding space using records from 'armgen list -json' or a spec",
+	run:   runClassify,
+}
+
+func readRecords(path string) ([]Record, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var records []Record
+	if err := json.Unmarshal(b, &records); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return records, nil
+}
+
+// loadRecords reads the records from a file written by 'armgen list -json',
+// or makes them from every instruction in a spec directory, sorted by ID as
+// 'list -json' does.
+func loadRecords(path string) ([]Record, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return readRecords(path)
+	}
+	model, err := LoadFeatureModel(path)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := LoadSpec(path)
+	if err != nil {
+		return nil, err
+	}
+	var records []Record
+	for _, f := range spec.Files {
+		records = append(records, NewRecords(f.Name, f.Insn, model)...)
+	}
+	by, _ := parseColumns("id")
+	sortRecords(records, by)
+	return records, nil
+}
+
+func heatmapFlags(records []Record, wrange, by string) (WordRange, []string, []int, error) {
+	r, err := ParseWordRange(wrange)
+	if err != nil {
+		return r, nil, nil, usagef("-range: %v", err)
+	}
+	names, cats, err := categorize(records, by)
+	if err != nil {
+		return r, nil, nil, usagef("-by: %v", err)
+	}
+	return r, names, cats, nil
+}
+
+func runClassify(fs *flag.FlagSet, args []string) error {
+	out := fs.String("out", "", "write the classification table to `file`")
+	in := fs.String("in", "", "read the classification table from `file`")
+	shards := fs.String("shards", "", "with -out, write the finished shards of the sweep to `dir` (default TABLE.shards) so that an interrupted sweep can resume")
+	keep := fs.Bool("keep", false, "with -out, keep the shards after writing the table")
+	lookup := fs.String("lookup", "", "with -in, print the records matching the comma-separated `words` instead of the listing")
+	stats := fs.Bool("stats", false, "compute how much of the word space the records use from their diagrams, without a table")
+	holes := fs.Bool("holes", false, "list the largest free encoding patterns, as x-masks, that no record matches")
+	reserve := fs.String("reserve", "", "with -holes, a comma-separated `list` of x-masks or diagrams to treat as allocated")
+	index := fs.String("index", "", "with -holes, treat the reserved and UNDEFINED space in the decode tree of this encodingindex.xml `file` as allocated (default SPECDIR/encodingindex.xml)")
+	words := fs.Bool("words", false, "classify the words read from the files, or stdin, printing one line per word")
+	input := fs.String("input", "hex", "with -words, the `format` of the words: hex (bare or objdump-style), le or be (raw 32-bit words)")
+	format := fs.String("format", "text", "with -words, write `text` or jsonl")
+	overlaps := fs.Bool("overlaps", false, "list the pairs of records that match a common word, with the number of such words")
+	top := fs.Int("top", 10, "with -stats or -holes, the number of unallocated ranges or patterns to list")
+	pngOut := fs.String("png", "", "draw the word space on a Hilbert curve to the PNG `file`, from the table with -in or else from the diagrams")
+	by := fs.String("by", "class", "with -png or the -in listing, group words by `key`: "+strings.Join(recordKeys, ", "))
+	block := fs.Int("block", 256, "with the -in listing, the number of `words` in each block")
+	wrange := fs.String("range", "0x00000000/0", "with -png, only draw the words in the `block` WORD/BITS, e.g. 0x8b000000/8")
+	size := fs.Int("size", 1024, "with -png, the maximum width and height of the curve in `pixels`")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	args = fs.Args()
+
+	if *out == "" && *in == "" && !*stats && !*holes && !*overlaps && *pngOut == "" && !*words {
+		return usagef("one of -out, -in, -stats, -holes, -overlaps, -png or -words is required")
+	}
+
+	if len(args) != 1 && !(*words && len(args) > 1) {
+		return usagef("expected a records file or spec directory")
+	}
+	records, err := loadRecords(args[0])
+	if err != nil {
+		return err
+	}
+
+	if *words {
+		if *format != "text" && *format != "jsonl" {
+			return usagef("-format: unknown format %q", *format)
+		}
+		if !validInput(*input) {
+			return usagef("-input: unknown format %q", *input)
+		}
+		return classifyStreams(os.Stdout, records, args[1:], *input, *format)
+	}
+
+	if *holes {
+		reserved, err := parseReserve(*reserve)
+		if err != nil {
+			return usagef("-reserve: %v", err)
+		}
+		path := *index
+		if path == "" {
+			if _, err := os.Stat(filepath.Join(args[0], indexFile)); err == nil {
+				path = args[0]
+			}
+		}
+		if path != "" {
+			tree, err := LoadReserved(path)
+			if err != nil {
+				return err
+			}
+			reserved = append(reserved, tree...)
+		}
+		hs, err := Holes(records, reserved, *top)
+		if err != nil {
+			return err
+		}
+		writeHoles(os.Stdout, hs, *top)
+		return nil
+	}
+
+	if *overlaps {
+		ov, err := Overlaps(records)
+		if err != nil {
+			return err
+		}
+		writeOverlaps(os.Stdout, records, ov)
+		return nil
+	}
+
+	if *stats {
+		c, err := NewCoverage(records)
+		if err != nil {
+			return err
+		}
+		c.WriteText(os.Stdout, *top)
+		return nil
+	}
+
+	if *pngOut != "" && *in == "" && *out == "" {
+		r, names, cats, err := heatmapFlags(records, *wrange, *by)
+		if err != nil {
+			return err
+		}
+		h, err := NewPatternHeatmap(records, cats, names, r, *size)
+		if err != nil {
+			return err
+		}
+		return writePNG(*pngOut, h.Image())
+	}
+
+	if *out != "" {
+		c, err := NewClassifier(records)
+		if err != nil {
+			return err
+		}
+		dir := *shards
+		if dir == "" {
+			dir = *out + ".shards"
+		}
+		if err := Sweep(dir, records, c.ClassifyPage); err != nil {
+			return err
+		}
+		if err := MergeShards(dir, *out, records); err != nil {
+			return err
+		}
+		if !*keep {
+			if err := removeShards(dir); err != nil {
+				return err
+			}
+		}
+	}
+
+	if *in != "" {
+		t, err := OpenTable(*in)
+		if err != nil {
+			return err
+		}
+		defer t.Close()
+		if int(t.Records) != len(records) {
+			return fmt.Errorf("%s was built from %d records but %s has %d", *in, t.Records, args[0], len(records))
+		}
+		if t.Hash != RecordsHash(records) {
+			return fmt.Errorf("%s was not built from the records in %s", *in, args[0])
+		}
+		if *lookup != "" {
+			for _, s := range strings.Split(*lookup, ",") {
+				w, ok := parseWord(s)
+				if !ok {
+					return usagef("-lookup: invalid word %q", s)
+				}
+				v, err := t.Lookup(w)
+				if err != nil {
+					return err
+				}
+				if v < 0 {
+					fmt.Printf("%08x: unallocated\n", w)
+				} else {
+					fmt.Printf("%08x: %s (%s)\n", w, records[v].ID, records[v].InstrClass)
+				}
+			}
+			return nil
+		}
+
+		if *pngOut != "" {
+			r, names, cats, err := heatmapFlags(records, *wrange, *by)
+			if err != nil {
+				return err
+			}
+			h, err := NewHeatmap(t, cats, names, r, *size)
+			if err != nil {
+				return err
+			}
+			return writePNG(*pngOut, h.Image())
+		}
+
+		if *block <= 0 || *block > PageSize || *block&(*block-1) != 0 {
+			return usagef("-block: must be a power of two up to %d", PageSize)
+		}
+		names, cats, err := categorize(records, *by)
+		if err != nil {
+			return usagef("-by: %v", err)
+		}
+		w := bufio.NewWriter(os.Stdout)
+		if err := WriteBlocks(w, t, cats, names, *block); err != nil {
+			return err
+		}
+		return w.Flush()
+	}
+	return nil
+}
@@ -1,14063 +0,0 @@
-package main
-var funcs = []func(uint32) bool{
-	parse_0,
-	parse_1,
-	parse_2,
-	parse_3,
-	parse_4,
-	parse_5,
-	parse_6,
-	parse_7,
-	parse_8,
-	parse_9,
-	parse_10,
-	parse_11,
-	parse_12,
-	parse_13,
-	parse_14,
-	parse_15,
-	parse_16,
-	parse_17,
-	parse_18,
-	parse_19,
-	parse_20,
-	parse_21,
-	parse_22,
-	parse_23,
-	parse_24,
-	parse_25,
-	parse_26,
-	parse_27,
-	parse_28,
-	parse_29,
-	parse_30,
-	parse_31,
-	parse_32,
-	parse_33,
-	parse_34,
-	parse_35,
-	parse_36,
-	parse_37,
-	parse_38,
-	parse_39,
-	parse_40,
-	parse_41,
-	parse_42,
-	parse_43,
-	parse_44,
-	parse_45,
-	parse_46,
-	parse_47,
-	parse_48,
-	parse_49,
-	parse_50,
-	parse_51,
-	parse_52,
-	parse_53,
-	parse_54,
-	parse_55,
-	parse_56,
-	parse_57,
-	parse_58,
-	parse_59,
-	parse_60,
-	parse_61,
-	parse_62,
-	parse_63,
-	parse_64,
-	parse_65,
-	parse_66,
-	parse_67,
-	parse_68,
-	parse_69,
-	parse_70,
-	parse_71,
-	parse_72,
-	parse_73,
-	parse_74,
-	parse_75,
-	parse_76,
-	parse_77,
-	parse_78,
-	parse_79,
-	parse_80,
-	parse_81,
-	parse_82,
-	parse_83,
-	parse_84,
-	parse_85,
-	parse_86,
-	parse_87,
-	parse_88,
-	parse_89,
-	parse_90,
-	parse_91,
-	parse_92,
-	parse_93,
-	parse_94,
-	parse_95,
-	parse_96,
-	parse_97,
-	parse_98,
-	parse_99,
-	parse_100,
-	parse_101,
-	parse_102,
-	parse_103,
-	parse_104,
-	parse_105,
-	parse_106,
-	parse_107,
-	parse_108,
-	parse_109,
-	parse_110,
-	parse_111,
-	parse_112,
-	parse_113,
-	parse_114,
-	parse_115,
-	parse_116,
-	parse_117,
-	parse_118,
-	parse_119,
-	parse_120,
-	parse_121,
-	parse_122,
-	parse_123,
-	parse_124,
-	parse_125,
-	parse_126,
-	parse_127,
-	parse_128,
-	parse_129,
-	parse_130,
-	parse_131,
-	parse_132,
-	parse_133,
-	parse_134,
-	parse_135,
-	parse_136,
-	parse_137,
-	parse_138,
-	parse_139,
-	parse_140,
-	parse_141,
-	parse_142,
-	parse_143,
-	parse_144,
-	parse_145,
-	parse_146,
-	parse_147,
-	parse_148,
-	parse_149,
-	parse_150,
-	parse_151,
-	parse_152,
-	parse_153,
-	parse_154,
-	parse_155,
-	parse_156,
-	parse_157,
-	parse_158,
-	parse_159,
-	parse_160,
-	parse_161,
-	parse_162,
-	parse_163,
-	parse_164,
-	parse_165,
-	parse_166,
-	parse_167,
-	parse_168,
-	parse_169,
-	parse_170,
-	parse_171,
-	parse_172,
-	parse_173,
-	parse_174,
-	parse_175,
-	parse_176,
-	parse_177,
-	parse_178,
-	parse_179,
-	parse_180,
-	parse_181,
-	parse_182,
-	parse_183,
-	parse_184,
-	parse_185,
-	parse_186,
-	parse_187,
-	parse_188,
-	parse_189,
-	parse_190,
-	parse_191,
-	parse_192,
-	parse_193,
-	parse_194,
-	parse_195,
-	parse_196,
-	parse_197,
-	parse_198,
-	parse_199,
-	parse_200,
-	parse_201,
-	parse_202,
-	parse_203,
-	parse_204,
-	parse_205,
-	parse_206,
-	parse_207,
-	parse_208,
-	parse_209,
-	parse_210,
-	parse_211,
-	parse_212,
-	parse_213,
-	parse_214,
-	parse_215,
-	parse_216,
-	parse_217,
-	parse_218,
-	parse_219,
-	parse_220,
-	parse_221,
-	parse_222,
-	parse_223,
-	parse_224,
-	parse_225,
-	parse_226,
-	parse_227,
-	parse_228,
-	parse_229,
-	parse_230,
-	parse_231,
-	parse_232,
-	parse_233,
-	parse_234,
-	parse_235,
-	parse_236,
-	parse_237,
-	parse_238,
-	parse_239,
-	parse_240,
-	parse_241,
-	parse_242,
-	parse_243,
-	parse_244,
-	parse_245,
-	parse_246,
-	parse_247,
-	parse_248,
-	parse_249,
-	parse_250,
-	parse_251,
-	parse_252,
-	parse_253,
-	parse_254,
-	parse_255,
-	parse_256,
-	parse_257,
-	parse_258,
-	parse_259,
-	parse_260,
-	parse_261,
-	parse_262,
-	parse_263,
-	parse_264,
-	parse_265,
-	parse_266,
-	parse_267,
-	parse_268,
-	parse_269,
-	parse_270,
-	parse_271,
-	parse_272,
-	parse_273,
-	parse_274,
-	parse_275,
-	parse_276,
-	parse_277,
-	parse_278,
-	parse_279,
-	parse_280,
-	parse_281,
-	parse_282,
-	parse_283,
-	parse_284,
-	parse_285,
-	parse_286,
-	parse_287,
-	parse_288,
-	parse_289,
-	parse_290,
-	parse_291,
-	parse_292,
-	parse_293,
-	parse_294,
-	parse_295,
-	parse_296,
-	parse_297,
-	parse_298,
-	parse_299,
-	parse_300,
-	parse_301,
-	parse_302,
-	parse_303,
-	parse_304,
-	parse_305,
-	parse_306,
-	parse_307,
-	parse_308,
-	parse_309,
-	parse_310,
-	parse_311,
-	parse_312,
-	parse_313,
-	parse_314,
-	parse_315,
-	parse_316,
-	parse_317,
-	parse_318,
-	parse_319,
-	parse_320,
-	parse_321,
-	parse_322,
-	parse_323,
-	parse_324,
-	parse_325,
-	parse_326,
-	parse_327,
-	parse_328,
-	parse_329,
-	parse_330,
-	parse_331,
-	parse_332,
-	parse_333,
-	parse_334,
-	parse_335,
-	parse_336,
-	parse_337,
-	parse_338,
-	parse_339,
-	parse_340,
-	parse_341,
-	parse_342,
-	parse_343,
-	parse_344,
-	parse_345,
-	parse_346,
-	parse_347,
-	parse_348,
-	parse_349,
-	parse_350,
-	parse_351,
-	parse_352,
-	parse_353,
-	parse_354,
-	parse_355,
-	parse_356,
-	parse_357,
-	parse_358,
-	parse_359,
-	parse_360,
-	parse_361,
-	parse_362,
-	parse_363,
-	parse_364,
-	parse_365,
-	parse_366,
-	parse_367,
-	parse_368,
-	parse_369,
-	parse_370,
-	parse_371,
-	parse_372,
-	parse_373,
-	parse_374,
-	parse_375,
-	parse_376,
-	parse_377,
-	parse_378,
-	parse_379,
-	parse_380,
-	parse_381,
-	parse_382,
-	parse_383,
-	parse_384,
-	parse_385,
-	parse_386,
-	parse_387,
-	parse_388,
-	parse_389,
-	parse_390,
-	parse_391,
-	parse_392,
-	parse_393,
-	parse_394,
-	parse_395,
-	parse_396,
-	parse_397,
-	parse_398,
-	parse_399,
-	parse_400,
-	parse_401,
-	parse_402,
-	parse_403,
-	parse_404,
-	parse_405,
-	parse_406,
-	parse_407,
-	parse_408,
-	parse_409,
-	parse_410,
-	parse_411,
-	parse_412,
-	parse_413,
-	parse_414,
-	parse_415,
-	parse_416,
-	parse_417,
-	parse_418,
-	parse_419,
-	parse_420,
-	parse_421,
-	parse_422,
-	parse_423,
-	parse_424,
-	parse_425,
-	parse_426,
-	parse_427,
-	parse_428,
-	parse_429,
-	parse_430,
-	parse_431,
-	parse_432,
-	parse_433,
-	parse_434,
-	parse_435,
-	parse_436,
-	parse_437,
-	parse_438,
-	parse_439,
-	parse_440,
-	parse_441,
-	parse_442,
-	parse_443,
-	parse_444,
-	parse_445,
-	parse_446,
-	parse_447,
-	parse_448,
-	parse_449,
-	parse_450,
-	parse_451,
-	parse_452,
-	parse_453,
-	parse_454,
-	parse_455,
-	parse_456,
-	parse_457,
-	parse_458,
-	parse_459,
-	parse_460,
-	parse_461,
-	parse_462,
-	parse_463,
-	parse_464,
-	parse_465,
-	parse_466,
-	parse_467,
-	parse_468,
-	parse_469,
-	parse_470,
-	parse_471,
-	parse_472,
-	parse_473,
-	parse_474,
-	parse_475,
-	parse_476,
-	parse_477,
-	parse_478,
-	parse_479,
-	parse_480,
-	parse_481,
-	parse_482,
-	parse_483,
-	parse_484,
-	parse_485,
-	parse_486,
-	parse_487,
-	parse_488,
-	parse_489,
-	parse_490,
-	parse_491,
-	parse_492,
-	parse_493,
-	parse_494,
-	parse_495,
-	parse_496,
-	parse_497,
-	parse_498,
-	parse_499,
-	parse_500,
-	parse_501,
-	parse_502,
-	parse_503,
-	parse_504,
-	parse_505,
-	parse_506,
-	parse_507,
-	parse_508,
-	parse_509,
-	parse_510,
-	parse_511,
-	parse_512,
-	parse_513,
-	parse_514,
-	parse_515,
-	parse_516,
-	parse_517,
-	parse_518,
-	parse_519,
-	parse_520,
-	parse_521,
-	parse_522,
-	parse_523,
-	parse_524,
-	parse_525,
-	parse_526,
-	parse_527,
-	parse_528,
-	parse_529,
-	parse_530,
-	parse_531,
-	parse_532,
-	parse_533,
-	parse_534,
-	parse_535,
-	parse_536,
-	parse_537,
-	parse_538,
-	parse_539,
-	parse_540,
-	parse_541,
-	parse_542,
-	parse_543,
-	parse_544,
-	parse_545,
-	parse_546,
-	parse_547,
-	parse_548,
-	parse_549,
-	parse_550,
-	parse_551,
-	parse_552,
-	parse_553,
-	parse_554,
-	parse_555,
-	parse_556,
-	parse_557,
-	parse_558,
-	parse_559,
-	parse_560,
-	parse_561,
-	parse_562,
-	parse_563,
-	parse_564,
-	parse_565,
-	parse_566,
-	parse_567,
-	parse_568,
-	parse_569,
-	parse_570,
-	parse_571,
-	parse_572,
-	parse_573,
-	parse_574,
-	parse_575,
-	parse_576,
-	parse_577,
-	parse_578,
-	parse_579,
-	parse_580,
-	parse_581,
-	parse_582,
-	parse_583,
-	parse_584,
-	parse_585,
-	parse_586,
-	parse_587,
-	parse_588,
-	parse_589,
-	parse_590,
-	parse_591,
-	parse_592,
-	parse_593,
-	parse_594,
-	parse_595,
-	parse_596,
-	parse_597,
-	parse_598,
-	parse_599,
-	parse_600,
-	parse_601,
-	parse_602,
-	parse_603,
-	parse_604,
-	parse_605,
-	parse_606,
-	parse_607,
-	parse_608,
-	parse_609,
-	parse_610,
-	parse_611,
-	parse_612,
-	parse_613,
-	parse_614,
-	parse_615,
-	parse_616,
-	parse_617,
-	parse_618,
-	parse_619,
-	parse_620,
-	parse_621,
-	parse_622,
-	parse_623,
-	parse_624,
-	parse_625,
-	parse_626,
-	parse_627,
-	parse_628,
-	parse_629,
-	parse_630,
-	parse_631,
-	parse_632,
-	parse_633,
-	parse_634,
-	parse_635,
-	parse_636,
-	parse_637,
-	parse_638,
-	parse_639,
-	parse_640,
-	parse_641,
-	parse_642,
-	parse_643,
-	parse_644,
-	parse_645,
-	parse_646,
-	parse_647,
-	parse_648,
-	parse_649,
-	parse_650,
-	parse_651,
-	parse_652,
-	parse_653,
-	parse_654,
-	parse_655,
-	parse_656,
-	parse_657,
-	parse_658,
-	parse_659,
-	parse_660,
-	parse_661,
-	parse_662,
-	parse_663,
-	parse_664,
-	parse_665,
-	parse_666,
-	parse_667,
-	parse_668,
-	parse_669,
-	parse_670,
-	parse_671,
-	parse_672,
-	parse_673,
-	parse_674,
-	parse_675,
-	parse_676,
-	parse_677,
-	parse_678,
-	parse_679,
-	parse_680,
-	parse_681,
-	parse_682,
-	parse_683,
-	parse_684,
-	parse_685,
-	parse_686,
-	parse_687,
-	parse_688,
-	parse_689,
-	parse_690,
-	parse_691,
-	parse_692,
-	parse_693,
-	parse_694,
-	parse_695,
-	parse_696,
-	parse_697,
-	parse_698,
-	parse_699,
-	parse_700,
-	parse_701,
-	parse_702,
-	parse_703,
-	parse_704,
-	parse_705,
-	parse_706,
-	parse_707,
-	parse_708,
-	parse_709,
-	parse_710,
-	parse_711,
-	parse_712,
-	parse_713,
-	parse_714,
-	parse_715,
-	parse_716,
-	parse_717,
-	parse_718,
-	parse_719,
-	parse_720,
-	parse_721,
-	parse_722,
-	parse_723,
-	parse_724,
-	parse_725,
-	parse_726,
-	parse_727,
-	parse_728,
-	parse_729,
-	parse_730,
-	parse_731,
-	parse_732,
-	parse_733,
-	parse_734,
-	parse_735,
-	parse_736,
-	parse_737,
-	parse_738,
-	parse_739,
-	parse_740,
-	parse_741,
-	parse_742,
-	parse_743,
-	parse_744,
-	parse_745,
-	parse_746,
-	parse_747,
-	parse_748,
-	parse_749,
-	parse_750,
-	parse_751,
-	parse_752,
-	parse_753,
-	parse_754,
-	parse_755,
-	parse_756,
-	parse_757,
-	parse_758,
-	parse_759,
-	parse_760,
-	parse_761,
-	parse_762,
-	parse_763,
-	parse_764,
-	parse_765,
-	parse_766,
-	parse_767,
-	parse_768,
-	parse_769,
-	parse_770,
-	parse_771,
-	parse_772,
-	parse_773,
-	parse_774,
-	parse_775,
-	parse_776,
-	parse_777,
-	parse_778,
-	parse_779,
-	parse_780,
-	parse_781,
-	parse_782,
-	parse_783,
-	parse_784,
-	parse_785,
-	parse_786,
-	parse_787,
-	parse_788,
-	parse_789,
-	parse_790,
-	parse_791,
-	parse_792,
-	parse_793,
-	parse_794,
-	parse_795,
-	parse_796,
-	parse_797,
-	parse_798,
-	parse_799,
-	parse_800,
-	parse_801,
-	parse_802,
-	parse_803,
-	parse_804,
-	parse_805,
-	parse_806,
-	parse_807,
-	parse_808,
-	parse_809,
-	parse_810,
-	parse_811,
-	parse_812,
-	parse_813,
-	parse_814,
-	parse_815,
-	parse_816,
-	parse_817,
-	parse_818,
-	parse_819,
-	parse_820,
-	parse_821,
-	parse_822,
-	parse_823,
-	parse_824,
-	parse_825,
-	parse_826,
-	parse_827,
-	parse_828,
-	parse_829,
-	parse_830,
-	parse_831,
-	parse_832,
-	parse_833,
-	parse_834,
-	parse_835,
-	parse_836,
-	parse_837,
-	parse_838,
-	parse_839,
-	parse_840,
-	parse_841,
-	parse_842,
-	parse_843,
-	parse_844,
-	parse_845,
-	parse_846,
-	parse_847,
-	parse_848,
-	parse_849,
-	parse_850,
-	parse_851,
-	parse_852,
-	parse_853,
-	parse_854,
-	parse_855,
-	parse_856,
-	parse_857,
-	parse_858,
-	parse_859,
-	parse_860,
-	parse_861,
-	parse_862,
-	parse_863,
-	parse_864,
-	parse_865,
-	parse_866,
-	parse_867,
-	parse_868,
-	parse_869,
-	parse_870,
-	parse_871,
-	parse_872,
-	parse_873,
-	parse_874,
-	parse_875,
-	parse_876,
-	parse_877,
-	parse_878,
-	parse_879,
-	parse_880,
-	parse_881,
-	parse_882,
-	parse_883,
-	parse_884,
-	parse_885,
-	parse_886,
-	parse_887,
-	parse_888,
-	parse_889,
-	parse_890,
-	parse_891,
-	parse_892,
-	parse_893,
-	parse_894,
-	parse_895,
-	parse_896,
-	parse_897,
-	parse_898,
-	parse_899,
-	parse_900,
-	parse_901,
-	parse_902,
-	parse_903,
-	parse_904,
-	parse_905,
-	parse_906,
-	parse_907,
-	parse_908,
-	parse_909,
-	parse_910,
-	parse_911,
-	parse_912,
-	parse_913,
-	parse_914,
-	parse_915,
-	parse_916,
-	parse_917,
-	parse_918,
-	parse_919,
-	parse_920,
-	parse_921,
-	parse_922,
-	parse_923,
-	parse_924,
-	parse_925,
-	parse_926,
-	parse_927,
-	parse_928,
-	parse_929,
-	parse_930,
-	parse_931,
-	parse_932,
-	parse_933,
-	parse_934,
-	parse_935,
-	parse_936,
-	parse_937,
-	parse_938,
-	parse_939,
-	parse_940,
-	parse_941,
-	parse_942,
-	parse_943,
-	parse_944,
-	parse_945,
-	parse_946,
-	parse_947,
-	parse_948,
-	parse_949,
-	parse_950,
-	parse_951,
-	parse_952,
-	parse_953,
-	parse_954,
-	parse_955,
-	parse_956,
-	parse_957,
-	parse_958,
-	parse_959,
-	parse_960,
-	parse_961,
-	parse_962,
-	parse_963,
-	parse_964,
-	parse_965,
-	parse_966,
-	parse_967,
-	parse_968,
-	parse_969,
-	parse_970,
-	parse_971,
-	parse_972,
-	parse_973,
-	parse_974,
-	parse_975,
-	parse_976,
-	parse_977,
-	parse_978,
-	parse_979,
-	parse_980,
-	parse_981,
-	parse_982,
-	parse_983,
-	parse_984,
-	parse_985,
-	parse_986,
-	parse_987,
-	parse_988,
-	parse_989,
-	parse_990,
-	parse_991,
-	parse_992,
-	parse_993,
-	parse_994,
-	parse_995,
-	parse_996,
-	parse_997,
-	parse_998,
-	parse_999,
-	parse_1000,
-	parse_1001,
-	parse_1002,
-	parse_1003,
-	parse_1004,
-	parse_1005,
-	parse_1006,
-	parse_1007,
-	parse_1008,
-	parse_1009,
-	parse_1010,
-	parse_1011,
-	parse_1012,
-	parse_1013,
-	parse_1014,
-	parse_1015,
-	parse_1016,
-	parse_1017,
-	parse_1018,
-	parse_1019,
-	parse_1020,
-	parse_1021,
-	parse_1022,
-	parse_1023,
-	parse_1024,
-	parse_1025,
-	parse_1026,
-	parse_1027,
-	parse_1028,
-	parse_1029,
-	parse_1030,
-	parse_1031,
-	parse_1032,
-	parse_1033,
-	parse_1034,
-	parse_1035,
-	parse_1036,
-	parse_1037,
-	parse_1038,
-	parse_1039,
-	parse_1040,
-	parse_1041,
-	parse_1042,
-	parse_1043,
-	parse_1044,
-	parse_1045,
-	parse_1046,
-	parse_1047,
-	parse_1048,
-	parse_1049,
-	parse_1050,
-	parse_1051,
-	parse_1052,
-	parse_1053,
-	parse_1054,
-	parse_1055,
-	parse_1056,
-	parse_1057,
-	parse_1058,
-	parse_1059,
-	parse_1060,
-	parse_1061,
-	parse_1062,
-	parse_1063,
-	parse_1064,
-	parse_1065,
-	parse_1066,
-	parse_1067,
-	parse_1068,
-	parse_1069,
-	parse_1070,
-	parse_1071,
-	parse_1072,
-	parse_1073,
-	parse_1074,
-	parse_1075,
-	parse_1076,
-	parse_1077,
-	parse_1078,
-	parse_1079,
-	parse_1080,
-	parse_1081,
-	parse_1082,
-	parse_1083,
-	parse_1084,
-	parse_1085,
-	parse_1086,
-	parse_1087,
-	parse_1088,
-	parse_1089,
-	parse_1090,
-	parse_1091,
-	parse_1092,
-	parse_1093,
-	parse_1094,
-	parse_1095,
-	parse_1096,
-	parse_1097,
-	parse_1098,
-	parse_1099,
-	parse_1100,
-	parse_1101,
-	parse_1102,
-	parse_1103,
-	parse_1104,
-	parse_1105,
-	parse_1106,
-	parse_1107,
-	parse_1108,
-	parse_1109,
-	parse_1110,
-	parse_1111,
-	parse_1112,
-	parse_1113,
-	parse_1114,
-	parse_1115,
-	parse_1116,
-	parse_1117,
-	parse_1118,
-	parse_1119,
-	parse_1120,
-	parse_1121,
-	parse_1122,
-	parse_1123,
-	parse_1124,
-	parse_1125,
-	parse_1126,
-	parse_1127,
-	parse_1128,
-	parse_1129,
-	parse_1130,
-	parse_1131,
-	parse_1132,
-	parse_1133,
-	parse_1134,
-	parse_1135,
-	parse_1136,
-	parse_1137,
-	parse_1138,
-	parse_1139,
-	parse_1140,
-	parse_1141,
-	parse_1142,
-	parse_1143,
-	parse_1144,
-	parse_1145,
-	parse_1146,
-	parse_1147,
-	parse_1148,
-	parse_1149,
-	parse_1150,
-	parse_1151,
-	parse_1152,
-	parse_1153,
-	parse_1154,
-	parse_1155,
-	parse_1156,
-	parse_1157,
-	parse_1158,
-	parse_1159,
-	parse_1160,
-	parse_1161,
-	parse_1162,
-	parse_1163,
-	parse_1164,
-	parse_1165,
-	parse_1166,
-	parse_1167,
-	parse_1168,
-	parse_1169,
-	parse_1170,
-	parse_1171,
-	parse_1172,
-	parse_1173,
-	parse_1174,
-	parse_1175,
-	parse_1176,
-	parse_1177,
-	parse_1178,
-	parse_1179,
-	parse_1180,
-	parse_1181,
-	parse_1182,
-	parse_1183,
-	parse_1184,
-	parse_1185,
-	parse_1186,
-	parse_1187,
-	parse_1188,
-	parse_1189,
-	parse_1190,
-	parse_1191,
-	parse_1192,
-	parse_1193,
-	parse_1194,
-	parse_1195,
-	parse_1196,
-	parse_1197,
-	parse_1198,
-	parse_1199,
-	parse_1200,
-	parse_1201,
-	parse_1202,
-	parse_1203,
-	parse_1204,
-	parse_1205,
-	parse_1206,
-	parse_1207,
-	parse_1208,
-	parse_1209,
-	parse_1210,
-	parse_1211,
-	parse_1212,
-	parse_1213,
-	parse_1214,
-	parse_1215,
-	parse_1216,
-	parse_1217,
-	parse_1218,
-	parse_1219,
-	parse_1220,
-	parse_1221,
-	parse_1222,
-	parse_1223,
-	parse_1224,
-	parse_1225,
-	parse_1226,
-	parse_1227,
-	parse_1228,
-	parse_1229,
-	parse_1230,
-	parse_1231,
-	parse_1232,
-	parse_1233,
-	parse_1234,
-	parse_1235,
-	parse_1236,
-	parse_1237,
-	parse_1238,
-	parse_1239,
-	parse_1240,
-	parse_1241,
-	parse_1242,
-	parse_1243,
-	parse_1244,
-	parse_1245,
-	parse_1246,
-	parse_1247,
-	parse_1248,
-	parse_1249,
-	parse_1250,
-	parse_1251,
-	parse_1252,
-	parse_1253,
-	parse_1254,
-	parse_1255,
-	parse_1256,
-	parse_1257,
-	parse_1258,
-	parse_1259,
-	parse_1260,
-	parse_1261,
-	parse_1262,
-	parse_1263,
-	parse_1264,
-	parse_1265,
-	parse_1266,
-	parse_1267,
-	parse_1268,
-	parse_1269,
-	parse_1270,
-	parse_1271,
-	parse_1272,
-	parse_1273,
-	parse_1274,
-	parse_1275,
-	parse_1276,
-	parse_1277,
-	parse_1278,
-	parse_1279,
-	parse_1280,
-	parse_1281,
-	parse_1282,
-	parse_1283,
-	parse_1284,
-	parse_1285,
-	parse_1286,
-	parse_1287,
-	parse_1288,
-	parse_1289,
-	parse_1290,
-	parse_1291,
-	parse_1292,
-	parse_1293,
-	parse_1294,
-	parse_1295,
-	parse_1296,
-	parse_1297,
-	parse_1298,
-	parse_1299,
-	parse_1300,
-	parse_1301,
-	parse_1302,
-	parse_1303,
-	parse_1304,
-	parse_1305,
-	parse_1306,
-	parse_1307,
-	parse_1308,
-	parse_1309,
-	parse_1310,
-	parse_1311,
-	parse_1312,
-	parse_1313,
-	parse_1314,
-	parse_1315,
-	parse_1316,
-	parse_1317,
-	parse_1318,
-	parse_1319,
-	parse_1320,
-	parse_1321,
-	parse_1322,
-	parse_1323,
-	parse_1324,
-	parse_1325,
-	parse_1326,
-	parse_1327,
-	parse_1328,
-	parse_1329,
-	parse_1330,
-	parse_1331,
-	parse_1332,
-	parse_1333,
-	parse_1334,
-	parse_1335,
-	parse_1336,
-	parse_1337,
-	parse_1338,
-	parse_1339,
-	parse_1340,
-	parse_1341,
-	parse_1342,
-	parse_1343,
-	parse_1344,
-	parse_1345,
-	parse_1346,
-	parse_1347,
-	parse_1348,
-	parse_1349,
-	parse_1350,
-	parse_1351,
-	parse_1352,
-	parse_1353,
-	parse_1354,
-	parse_1355,
-	parse_1356,
-	parse_1357,
-	parse_1358,
-	parse_1359,
-	parse_1360,
-	parse_1361,
-	parse_1362,
-	parse_1363,
-	parse_1364,
-	parse_1365,
-	parse_1366,
-	parse_1367,
-	parse_1368,
-	parse_1369,
-	parse_1370,
-	parse_1371,
-	parse_1372,
-	parse_1373,
-	parse_1374,
-	parse_1375,
-	parse_1376,
-	parse_1377,
-	parse_1378,
-	parse_1379,
-	parse_1380,
-	parse_1381,
-	parse_1382,
-	parse_1383,
-	parse_1384,
-	parse_1385,
-	parse_1386,
-	parse_1387,
-	parse_1388,
-	parse_1389,
-	parse_1390,
-	parse_1391,
-	parse_1392,
-	parse_1393,
-	parse_1394,
-	parse_1395,
-	parse_1396,
-	parse_1397,
-	parse_1398,
-	parse_1399,
-	parse_1400,
-	parse_1401,
-	parse_1402,
-	parse_1403,
-	parse_1404,
-	parse_1405,
-	parse_1406,
-	parse_1407,
-	parse_1408,
-	parse_1409,
-	parse_1410,
-	parse_1411,
-	parse_1412,
-	parse_1413,
-	parse_1414,
-	parse_1415,
-	parse_1416,
-	parse_1417,
-	parse_1418,
-	parse_1419,
-	parse_1420,
-	parse_1421,
-	parse_1422,
-	parse_1423,
-	parse_1424,
-	parse_1425,
-	parse_1426,
-	parse_1427,
-	parse_1428,
-	parse_1429,
-	parse_1430,
-	parse_1431,
-	parse_1432,
-	parse_1433,
-	parse_1434,
-	parse_1435,
-	parse_1436,
-	parse_1437,
-	parse_1438,
-	parse_1439,
-	parse_1440,
-	parse_1441,
-	parse_1442,
-	parse_1443,
-	parse_1444,
-	parse_1445,
-	parse_1446,
-	parse_1447,
-	parse_1448,
-	parse_1449,
-	parse_1450,
-	parse_1451,
-	parse_1452,
-	parse_1453,
-	parse_1454,
-	parse_1455,
-	parse_1456,
-	parse_1457,
-	parse_1458,
-	parse_1459,
-	parse_1460,
-	parse_1461,
-	parse_1462,
-	parse_1463,
-	parse_1464,
-	parse_1465,
-	parse_1466,
-	parse_1467,
-	parse_1468,
-	parse_1469,
-	parse_1470,
-	parse_1471,
-	parse_1472,
-	parse_1473,
-	parse_1474,
-	parse_1475,
-	parse_1476,
-	parse_1477,
-	parse_1478,
-	parse_1479,
-	parse_1480,
-	parse_1481,
-	parse_1482,
-	parse_1483,
-	parse_1484,
-	parse_1485,
-	parse_1486,
-	parse_1487,
-	parse_1488,
-	parse_1489,
-	parse_1490,
-	parse_1491,
-	parse_1492,
-	parse_1493,
-	parse_1494,
-	parse_1495,
-	parse_1496,
-	parse_1497,
-	parse_1498,
-	parse_1499,
-	parse_1500,
-	parse_1501,
-	parse_1502,
-	parse_1503,
-	parse_1504,
-	parse_1505,
-	parse_1506,
-	parse_1507,
-	parse_1508,
-	parse_1509,
-	parse_1510,
-	parse_1511,
-	parse_1512,
-	parse_1513,
-	parse_1514,
-	parse_1515,
-	parse_1516,
-	parse_1517,
-	parse_1518,
-	parse_1519,
-	parse_1520,
-	parse_1521,
-	parse_1522,
-	parse_1523,
-	parse_1524,
-	parse_1525,
-	parse_1526,
-	parse_1527,
-	parse_1528,
-	parse_1529,
-	parse_1530,
-	parse_1531,
-	parse_1532,
-	parse_1533,
-	parse_1534,
-	parse_1535,
-	parse_1536,
-	parse_1537,
-	parse_1538,
-	parse_1539,
-	parse_1540,
-	parse_1541,
-	parse_1542,
-	parse_1543,
-	parse_1544,
-	parse_1545,
-	parse_1546,
-	parse_1547,
-	parse_1548,
-	parse_1549,
-	parse_1550,
-	parse_1551,
-	parse_1552,
-	parse_1553,
-	parse_1554,
-	parse_1555,
-	parse_1556,
-	parse_1557,
-	parse_1558,
-	parse_1559,
-	parse_1560,
-	parse_1561,
-	parse_1562,
-	parse_1563,
-	parse_1564,
-	parse_1565,
-	parse_1566,
-	parse_1567,
-	parse_1568,
-	parse_1569,
-	parse_1570,
-	parse_1571,
-	parse_1572,
-	parse_1573,
-	parse_1574,
-	parse_1575,
-	parse_1576,
-	parse_1577,
-	parse_1578,
-	parse_1579,
-	parse_1580,
-	parse_1581,
-	parse_1582,
-	parse_1583,
-	parse_1584,
-	parse_1585,
-	parse_1586,
-	parse_1587,
-	parse_1588,
-	parse_1589,
-	parse_1590,
-	parse_1591,
-	parse_1592,
-	parse_1593,
-	parse_1594,
-	parse_1595,
-	parse_1596,
-	parse_1597,
-	parse_1598,
-	parse_1599,
-	parse_1600,
-	parse_1601,
-	parse_1602,
-	parse_1603,
-	parse_1604,
-	parse_1605,
-	parse_1606,
-	parse_1607,
-	parse_1608,
-	parse_1609,
-	parse_1610,
-	parse_1611,
-	parse_1612,
-	parse_1613,
-	parse_1614,
-	parse_1615,
-	parse_1616,
-	parse_1617,
-	parse_1618,
-	parse_1619,
-	parse_1620,
-	parse_1621,
-	parse_1622,
-	parse_1623,
-	parse_1624,
-	parse_1625,
-	parse_1626,
-	parse_1627,
-	parse_1628,
-	parse_1629,
-	parse_1630,
-	parse_1631,
-	parse_1632,
-	parse_1633,
-	parse_1634,
-	parse_1635,
-	parse_1636,
-	parse_1637,
-	parse_1638,
-	parse_1639,
-	parse_1640,
-	parse_1641,
-	parse_1642,
-	parse_1643,
-	parse_1644,
-	parse_1645,
-	parse_1646,
-	parse_1647,
-	parse_1648,
-	parse_1649,
-	parse_1650,
-	parse_1651,
-	parse_1652,
-	parse_1653,
-	parse_1654,
-	parse_1655,
-	parse_1656,
-	parse_1657,
-	parse_1658,
-	parse_1659,
-	parse_1660,
-	parse_1661,
-	parse_1662,
-	parse_1663,
-	parse_1664,
-	parse_1665,
-	parse_1666,
-	parse_1667,
-	parse_1668,
-	parse_1669,
-	parse_1670,
-	parse_1671,
-	parse_1672,
-	parse_1673,
-	parse_1674,
-	parse_1675,
-	parse_1676,
-	parse_1677,
-	parse_1678,
-	parse_1679,
-	parse_1680,
-	parse_1681,
-	parse_1682,
-	parse_1683,
-	parse_1684,
-	parse_1685,
-	parse_1686,
-	parse_1687,
-	parse_1688,
-	parse_1689,
-	parse_1690,
-	parse_1691,
-	parse_1692,
-	parse_1693,
-	parse_1694,
-	parse_1695,
-	parse_1696,
-	parse_1697,
-	parse_1698,
-	parse_1699,
-	parse_1700,
-	parse_1701,
-	parse_1702,
-	parse_1703,
-	parse_1704,
-	parse_1705,
-	parse_1706,
-	parse_1707,
-	parse_1708,
-	parse_1709,
-	parse_1710,
-	parse_1711,
-	parse_1712,
-	parse_1713,
-	parse_1714,
-	parse_1715,
-	parse_1716,
-	parse_1717,
-	parse_1718,
-	parse_1719,
-	parse_1720,
-	parse_1721,
-	parse_1722,
-	parse_1723,
-	parse_1724,
-	parse_1725,
-	parse_1726,
-	parse_1727,
-	parse_1728,
-	parse_1729,
-	parse_1730,
-	parse_1731,
-	parse_1732,
-	parse_1733,
-	parse_1734,
-	parse_1735,
-	parse_1736,
-	parse_1737,
-	parse_1738,
-	parse_1739,
-	parse_1740,
-	parse_1741,
-	parse_1742,
-	parse_1743,
-	parse_1744,
-	parse_1745,
-	parse_1746,
-	parse_1747,
-	parse_1748,
-	parse_1749,
-	parse_1750,
-	parse_1751,
-	parse_1752,
-	parse_1753,
-	parse_1754,
-	parse_1755,
-	parse_1756,
-	parse_1757,
-	parse_1758,
-	parse_1759,
-	parse_1760,
-	parse_1761,
-	parse_1762,
-	parse_1763,
-	parse_1764,
-	parse_1765,
-	parse_1766,
-	parse_1767,
-	parse_1768,
-	parse_1769,
-	parse_1770,
-	parse_1771,
-	parse_1772,
-	parse_1773,
-	parse_1774,
-	parse_1775,
-	parse_1776,
-	parse_1777,
-	parse_1778,
-	parse_1779,
-	parse_1780,
-	parse_1781,
-	parse_1782,
-	parse_1783,
-	parse_1784,
-	parse_1785,
-	parse_1786,
-	parse_1787,
-	parse_1788,
-	parse_1789,
-	parse_1790,
-	parse_1791,
-	parse_1792,
-	parse_1793,
-	parse_1794,
-	parse_1795,
-	parse_1796,
-	parse_1797,
-	parse_1798,
-	parse_1799,
-	parse_1800,
-	parse_1801,
-	parse_1802,
-	parse_1803,
-	parse_1804,
-	parse_1805,
-	parse_1806,
-	parse_1807,
-	parse_1808,
-	parse_1809,
-	parse_1810,
-	parse_1811,
-	parse_1812,
-	parse_1813,
-	parse_1814,
-	parse_1815,
-	parse_1816,
-	parse_1817,
-	parse_1818,
-	parse_1819,
-	parse_1820,
-	parse_1821,
-	parse_1822,
-	parse_1823,
-	parse_1824,
-	parse_1825,
-	parse_1826,
-	parse_1827,
-	parse_1828,
-	parse_1829,
-	parse_1830,
-	parse_1831,
-	parse_1832,
-	parse_1833,
-	parse_1834,
-	parse_1835,
-	parse_1836,
-	parse_1837,
-	parse_1838,
-	parse_1839,
-	parse_1840,
-	parse_1841,
-	parse_1842,
-	parse_1843,
-	parse_1844,
-	parse_1845,
-	parse_1846,
-	parse_1847,
-	parse_1848,
-	parse_1849,
-	parse_1850,
-	parse_1851,
-	parse_1852,
-	parse_1853,
-	parse_1854,
-	parse_1855,
-	parse_1856,
-	parse_1857,
-	parse_1858,
-	parse_1859,
-	parse_1860,
-	parse_1861,
-	parse_1862,
-	parse_1863,
-	parse_1864,
-	parse_1865,
-	parse_1866,
-	parse_1867,
-	parse_1868,
-	parse_1869,
-	parse_1870,
-	parse_1871,
-	parse_1872,
-	parse_1873,
-	parse_1874,
-	parse_1875,
-	parse_1876,
-	parse_1877,
-	parse_1878,
-	parse_1879,
-	parse_1880,
-	parse_1881,
-	parse_1882,
-	parse_1883,
-	parse_1884,
-	parse_1885,
-	parse_1886,
-	parse_1887,
-	parse_1888,
-	parse_1889,
-	parse_1890,
-	parse_1891,
-	parse_1892,
-	parse_1893,
-	parse_1894,
-	parse_1895,
-	parse_1896,
-	parse_1897,
-	parse_1898,
-	parse_1899,
-	parse_1900,
-	parse_1901,
-	parse_1902,
-	parse_1903,
-	parse_1904,
-	parse_1905,
-	parse_1906,
-	parse_1907,
-	parse_1908,
-	parse_1909,
-	parse_1910,
-	parse_1911,
-	parse_1912,
-	parse_1913,
-	parse_1914,
-	parse_1915,
-	parse_1916,
-	parse_1917,
-	parse_1918,
-	parse_1919,
-	parse_1920,
-	parse_1921,
-	parse_1922,
-	parse_1923,
-	parse_1924,
-	parse_1925,
-	parse_1926,
-	parse_1927,
-	parse_1928,
-	parse_1929,
-	parse_1930,
-	parse_1931,
-	parse_1932,
-	parse_1933,
-	parse_1934,
-	parse_1935,
-	parse_1936,
-	parse_1937,
-	parse_1938,
-	parse_1939,
-	parse_1940,
-	parse_1941,
-	parse_1942,
-	parse_1943,
-	parse_1944,
-	parse_1945,
-	parse_1946,
-	parse_1947,
-	parse_1948,
-	parse_1949,
-	parse_1950,
-	parse_1951,
-	parse_1952,
-	parse_1953,
-	parse_1954,
-	parse_1955,
-	parse_1956,
-	parse_1957,
-	parse_1958,
-	parse_1959,
-	parse_1960,
-	parse_1961,
-	parse_1962,
-	parse_1963,
-	parse_1964,
-	parse_1965,
-	parse_1966,
-	parse_1967,
-	parse_1968,
-	parse_1969,
-	parse_1970,
-	parse_1971,
-	parse_1972,
-	parse_1973,
-	parse_1974,
-	parse_1975,
-	parse_1976,
-	parse_1977,
-	parse_1978,
-	parse_1979,
-	parse_1980,
-	parse_1981,
-	parse_1982,
-	parse_1983,
-	parse_1984,
-	parse_1985,
-	parse_1986,
-	parse_1987,
-	parse_1988,
-	parse_1989,
-	parse_1990,
-	parse_1991,
-	parse_1992,
-	parse_1993,
-	parse_1994,
-	parse_1995,
-	parse_1996,
-	parse_1997,
-	parse_1998,
-	parse_1999,
-	parse_2000,
-	parse_2001,
-	parse_2002,
-	parse_2003,
-	parse_2004,
-	parse_2005,
-	parse_2006,
-	parse_2007,
-	parse_2008,
-	parse_2009,
-	parse_2010,
-	parse_2011,
-	parse_2012,
-	parse_2013,
-	parse_2014,
-	parse_2015,
-	parse_2016,
-	parse_2017,
-	parse_2018,
-	parse_2019,
-	parse_2020,
-	parse_2021,
-	parse_2022,
-	parse_2023,
-	parse_2024,
-	parse_2025,
-	parse_2026,
-	parse_2027,
-	parse_2028,
-	parse_2029,
-	parse_2030,
-	parse_2031,
-	parse_2032,
-	parse_2033,
-	parse_2034,
-	parse_2035,
-	parse_2036,
-	parse_2037,
-	parse_2038,
-	parse_2039,
-	parse_2040,
-	parse_2041,
-	parse_2042,
-	parse_2043,
-	parse_2044,
-	parse_2045,
-	parse_2046,
-	parse_2047,
-	parse_2048,
-	parse_2049,
-	parse_2050,
-	parse_2051,
-	parse_2052,
-	parse_2053,
-	parse_2054,
-	parse_2055,
-	parse_2056,
-	parse_2057,
-	parse_2058,
-	parse_2059,
-	parse_2060,
-	parse_2061,
-	parse_2062,
-	parse_2063,
-	parse_2064,
-	parse_2065,
-	parse_2066,
-	parse_2067,
-	parse_2068,
-	parse_2069,
-	parse_2070,
-	parse_2071,
-	parse_2072,
-	parse_2073,
-	parse_2074,
-	parse_2075,
-	parse_2076,
-	parse_2077,
-	parse_2078,
-	parse_2079,
-	parse_2080,
-	parse_2081,
-	parse_2082,
-	parse_2083,
-	parse_2084,
-	parse_2085,
-	parse_2086,
-	parse_2087,
-	parse_2088,
-	parse_2089,
-	parse_2090,
-	parse_2091,
-	parse_2092,
-	parse_2093,
-	parse_2094,
-	parse_2095,
-	parse_2096,
-	parse_2097,
-	parse_2098,
-	parse_2099,
-	parse_2100,
-	parse_2101,
-	parse_2102,
-	parse_2103,
-	parse_2104,
-	parse_2105,
-	parse_2106,
-	parse_2107,
-	parse_2108,
-	parse_2109,
-	parse_2110,
-	parse_2111,
-	parse_2112,
-	parse_2113,
-	parse_2114,
-	parse_2115,
-	parse_2116,
-	parse_2117,
-	parse_2118,
-	parse_2119,
-	parse_2120,
-	parse_2121,
-	parse_2122,
-	parse_2123,
-	parse_2124,
-	parse_2125,
-	parse_2126,
-	parse_2127,
-	parse_2128,
-	parse_2129,
-	parse_2130,
-	parse_2131,
-	parse_2132,
-	parse_2133,
-	parse_2134,
-	parse_2135,
-	parse_2136,
-	parse_2137,
-	parse_2138,
-	parse_2139,
-	parse_2140,
-	parse_2141,
-	parse_2142,
-	parse_2143,
-	parse_2144,
-	parse_2145,
-	parse_2146,
-	parse_2147,
-	parse_2148,
-	parse_2149,
-	parse_2150,
-	parse_2151,
-	parse_2152,
-	parse_2153,
-	parse_2154,
-	parse_2155,
-	parse_2156,
-	parse_2157,
-	parse_2158,
-	parse_2159,
-	parse_2160,
-	parse_2161,
-	parse_2162,
-	parse_2163,
-	parse_2164,
-	parse_2165,
-	parse_2166,
-	parse_2167,
-	parse_2168,
-	parse_2169,
-	parse_2170,
-	parse_2171,
-	parse_2172,
-	parse_2173,
-	parse_2174,
-	parse_2175,
-	parse_2176,
-	parse_2177,
-	parse_2178,
-	parse_2179,
-	parse_2180,
-	parse_2181,
-	parse_2182,
-	parse_2183,
-	parse_2184,
-	parse_2185,
-	parse_2186,
-	parse_2187,
-	parse_2188,
-	parse_2189,
-	parse_2190,
-	parse_2191,
-	parse_2192,
-	parse_2193,
-	parse_2194,
-	parse_2195,
-	parse_2196,
-	parse_2197,
-	parse_2198,
-	parse_2199,
-	parse_2200,
-	parse_2201,
-	parse_2202,
-	parse_2203,
-	parse_2204,
-	parse_2205,
-	parse_2206,
-	parse_2207,
-	parse_2208,
-	parse_2209,
-	parse_2210,
-	parse_2211,
-	parse_2212,
-	parse_2213,
-	parse_2214,
-	parse_2215,
-	parse_2216,
-	parse_2217,
-	parse_2218,
-	parse_2219,
-	parse_2220,
-	parse_2221,
-	parse_2222,
-	parse_2223,
-	parse_2224,
-	parse_2225,
-	parse_2226,
-	parse_2227,
-	parse_2228,
-	parse_2229,
-	parse_2230,
-	parse_2231,
-	parse_2232,
-	parse_2233,
-	parse_2234,
-	parse_2235,
-	parse_2236,
-	parse_2237,
-	parse_2238,
-	parse_2239,
-	parse_2240,
-	parse_2241,
-	parse_2242,
-	parse_2243,
-	parse_2244,
-	parse_2245,
-	parse_2246,
-	parse_2247,
-	parse_2248,
-	parse_2249,
-	parse_2250,
-	parse_2251,
-	parse_2252,
-	parse_2253,
-	parse_2254,
-	parse_2255,
-	parse_2256,
-	parse_2257,
-	parse_2258,
-	parse_2259,
-	parse_2260,
-	parse_2261,
-	parse_2262,
-	parse_2263,
-	parse_2264,
-	parse_2265,
-	parse_2266,
-	parse_2267,
-	parse_2268,
-	parse_2269,
-	parse_2270,
-	parse_2271,
-	parse_2272,
-	parse_2273,
-	parse_2274,
-	parse_2275,
-	parse_2276,
-	parse_2277,
-	parse_2278,
-	parse_2279,
-	parse_2280,
-	parse_2281,
-	parse_2282,
-	parse_2283,
-	parse_2284,
-	parse_2285,
-	parse_2286,
-	parse_2287,
-	parse_2288,
-	parse_2289,
-	parse_2290,
-	parse_2291,
-	parse_2292,
-	parse_2293,
-	parse_2294,
-	parse_2295,
-	parse_2296,
-	parse_2297,
-	parse_2298,
-	parse_2299,
-	parse_2300,
-	parse_2301,
-	parse_2302,
-	parse_2303,
-	parse_2304,
-	parse_2305,
-	parse_2306,
-	parse_2307,
-	parse_2308,
-	parse_2309,
-	parse_2310,
-	parse_2311,
-	parse_2312,
-	parse_2313,
-	parse_2314,
-	parse_2315,
-	parse_2316,
-	parse_2317,
-	parse_2318,
-	parse_2319,
-	parse_2320,
-	parse_2321,
-	parse_2322,
-	parse_2323,
-	parse_2324,
-	parse_2325,
-	parse_2326,
-	parse_2327,
-	parse_2328,
-	parse_2329,
-	parse_2330,
-	parse_2331,
-	parse_2332,
-	parse_2333,
-	parse_2334,
-	parse_2335,
-	parse_2336,
-	parse_2337,
-	parse_2338,
-	parse_2339,
-	parse_2340,
-	parse_2341,
-	parse_2342,
-	parse_2343,
-	parse_2344,
-	parse_2345,
-	parse_2346,
-	parse_2347,
-	parse_2348,
-	parse_2349,
-	parse_2350,
-	parse_2351,
-	parse_2352,
-	parse_2353,
-	parse_2354,
-	parse_2355,
-	parse_2356,
-	parse_2357,
-	parse_2358,
-	parse_2359,
-	parse_2360,
-	parse_2361,
-	parse_2362,
-	parse_2363,
-	parse_2364,
-	parse_2365,
-	parse_2366,
-	parse_2367,
-	parse_2368,
-	parse_2369,
-	parse_2370,
-	parse_2371,
-	parse_2372,
-	parse_2373,
-	parse_2374,
-	parse_2375,
-	parse_2376,
-	parse_2377,
-	parse_2378,
-	parse_2379,
-	parse_2380,
-	parse_2381,
-	parse_2382,
-	parse_2383,
-	parse_2384,
-	parse_2385,
-	parse_2386,
-	parse_2387,
-	parse_2388,
-	parse_2389,
-	parse_2390,
-	parse_2391,
-	parse_2392,
-	parse_2393,
-	parse_2394,
-	parse_2395,
-	parse_2396,
-	parse_2397,
-	parse_2398,
-	parse_2399,
-	parse_2400,
-	parse_2401,
-	parse_2402,
-	parse_2403,
-	parse_2404,
-	parse_2405,
-	parse_2406,
-	parse_2407,
-	parse_2408,
-	parse_2409,
-	parse_2410,
-	parse_2411,
-	parse_2412,
-	parse_2413,
-	parse_2414,
-	parse_2415,
-	parse_2416,
-	parse_2417,
-	parse_2418,
-	parse_2419,
-	parse_2420,
-	parse_2421,
-	parse_2422,
-	parse_2423,
-	parse_2424,
-	parse_2425,
-	parse_2426,
-	parse_2427,
-	parse_2428,
-	parse_2429,
-	parse_2430,
-	parse_2431,
-	parse_2432,
-	parse_2433,
-	parse_2434,
-	parse_2435,
-	parse_2436,
-	parse_2437,
-	parse_2438,
-	parse_2439,
-	parse_2440,
-	parse_2441,
-	parse_2442,
-	parse_2443,
-	parse_2444,
-	parse_2445,
-	parse_2446,
-	parse_2447,
-	parse_2448,
-	parse_2449,
-	parse_2450,
-	parse_2451,
-	parse_2452,
-	parse_2453,
-	parse_2454,
-	parse_2455,
-	parse_2456,
-	parse_2457,
-	parse_2458,
-	parse_2459,
-	parse_2460,
-	parse_2461,
-	parse_2462,
-	parse_2463,
-	parse_2464,
-	parse_2465,
-	parse_2466,
-	parse_2467,
-	parse_2468,
-	parse_2469,
-	parse_2470,
-	parse_2471,
-	parse_2472,
-	parse_2473,
-	parse_2474,
-	parse_2475,
-	parse_2476,
-	parse_2477,
-	parse_2478,
-	parse_2479,
-	parse_2480,
-	parse_2481,
-	parse_2482,
-	parse_2483,
-	parse_2484,
-	parse_2485,
-	parse_2486,
-	parse_2487,
-	parse_2488,
-	parse_2489,
-	parse_2490,
-	parse_2491,
-	parse_2492,
-	parse_2493,
-	parse_2494,
-	parse_2495,
-	parse_2496,
-	parse_2497,
-	parse_2498,
-	parse_2499,
-	parse_2500,
-	parse_2501,
-	parse_2502,
-	parse_2503,
-	parse_2504,
-	parse_2505,
-	parse_2506,
-	parse_2507,
-	parse_2508,
-	parse_2509,
-	parse_2510,
-	parse_2511,
-	parse_2512,
-	parse_2513,
-	parse_2514,
-	parse_2515,
-	parse_2516,
-	parse_2517,
-	parse_2518,
-	parse_2519,
-	parse_2520,
-	parse_2521,
-	parse_2522,
-	parse_2523,
-	parse_2524,
-	parse_2525,
-	parse_2526,
-	parse_2527,
-	parse_2528,
-	parse_2529,
-	parse_2530,
-	parse_2531,
-	parse_2532,
-	parse_2533,
-	parse_2534,
-	parse_2535,
-	parse_2536,
-	parse_2537,
-	parse_2538,
-	parse_2539,
-	parse_2540,
-	parse_2541,
-	parse_2542,
-	parse_2543,
-	parse_2544,
-	parse_2545,
-	parse_2546,
-	parse_2547,
-	parse_2548,
-	parse_2549,
-	parse_2550,
-	parse_2551,
-	parse_2552,
-	parse_2553,
-	parse_2554,
-	parse_2555,
-	parse_2556,
-	parse_2557,
-	parse_2558,
-	parse_2559,
-	parse_2560,
-	parse_2561,
-	parse_2562,
-	parse_2563,
-	parse_2564,
-	parse_2565,
-	parse_2566,
-	parse_2567,
-	parse_2568,
-	parse_2569,
-	parse_2570,
-	parse_2571,
-	parse_2572,
-	parse_2573,
-	parse_2574,
-	parse_2575,
-	parse_2576,
-	parse_2577,
-	parse_2578,
-	parse_2579,
-	parse_2580,
-	parse_2581,
-	parse_2582,
-	parse_2583,
-	parse_2584,
-	parse_2585,
-	parse_2586,
-	parse_2587,
-	parse_2588,
-	parse_2589,
-	parse_2590,
-	parse_2591,
-	parse_2592,
-	parse_2593,
-	parse_2594,
-	parse_2595,
-	parse_2596,
-	parse_2597,
-	parse_2598,
-	parse_2599,
-	parse_2600,
-	parse_2601,
-	parse_2602,
-	parse_2603,
-	parse_2604,
-	parse_2605,
-	parse_2606,
-	parse_2607,
-	parse_2608,
-	parse_2609,
-	parse_2610,
-	parse_2611,
-	parse_2612,
-	parse_2613,
-	parse_2614,
-	parse_2615,
-	parse_2616,
-	parse_2617,
-	parse_2618,
-	parse_2619,
-	parse_2620,
-	parse_2621,
-	parse_2622,
-	parse_2623,
-	parse_2624,
-	parse_2625,
-	parse_2626,
-	parse_2627,
-	parse_2628,
-	parse_2629,
-	parse_2630,
-	parse_2631,
-	parse_2632,
-	parse_2633,
-	parse_2634,
-	parse_2635,
-	parse_2636,
-	parse_2637,
-	parse_2638,
-	parse_2639,
-	parse_2640,
-	parse_2641,
-	parse_2642,
-	parse_2643,
-	parse_2644,
-	parse_2645,
-	parse_2646,
-	parse_2647,
-	parse_2648,
-	parse_2649,
-	parse_2650,
-	parse_2651,
-	parse_2652,
-	parse_2653,
-	parse_2654,
-	parse_2655,
-	parse_2656,
-	parse_2657,
-	parse_2658,
-	parse_2659,
-	parse_2660,
-	parse_2661,
-	parse_2662,
-	parse_2663,
-	parse_2664,
-	parse_2665,
-	parse_2666,
-	parse_2667,
-	parse_2668,
-	parse_2669,
-	parse_2670,
-	parse_2671,
-	parse_2672,
-	parse_2673,
-	parse_2674,
-	parse_2675,
-	parse_2676,
-	parse_2677,
-	parse_2678,
-	parse_2679,
-	parse_2680,
-	parse_2681,
-	parse_2682,
-	parse_2683,
-	parse_2684,
-	parse_2685,
-	parse_2686,
-	parse_2687,
-	parse_2688,
-	parse_2689,
-	parse_2690,
-	parse_2691,
-	parse_2692,
-	parse_2693,
-	parse_2694,
-	parse_2695,
-	parse_2696,
-	parse_2697,
-	parse_2698,
-	parse_2699,
-	parse_2700,
-	parse_2701,
-	parse_2702,
-	parse_2703,
-	parse_2704,
-	parse_2705,
-	parse_2706,
-	parse_2707,
-	parse_2708,
-	parse_2709,
-	parse_2710,
-	parse_2711,
-	parse_2712,
-	parse_2713,
-	parse_2714,
-	parse_2715,
-	parse_2716,
-	parse_2717,
-	parse_2718,
-	parse_2719,
-	parse_2720,
-	parse_2721,
-	parse_2722,
-	parse_2723,
-	parse_2724,
-	parse_2725,
-	parse_2726,
-	parse_2727,
-	parse_2728,
-	parse_2729,
-	parse_2730,
-	parse_2731,
-	parse_2732,
-	parse_2733,
-	parse_2734,
-	parse_2735,
-	parse_2736,
-	parse_2737,
-	parse_2738,
-	parse_2739,
-	parse_2740,
-	parse_2741,
-	parse_2742,
-	parse_2743,
-	parse_2744,
-	parse_2745,
-	parse_2746,
-	parse_2747,
-	parse_2748,
-	parse_2749,
-	parse_2750,
-	parse_2751,
-	parse_2752,
-	parse_2753,
-	parse_2754,
-	parse_2755,
-	parse_2756,
-	parse_2757,
-	parse_2758,
-	parse_2759,
-	parse_2760,
-	parse_2761,
-	parse_2762,
-	parse_2763,
-	parse_2764,
-	parse_2765,
-	parse_2766,
-	parse_2767,
-	parse_2768,
-	parse_2769,
-	parse_2770,
-	parse_2771,
-	parse_2772,
-	parse_2773,
-	parse_2774,
-	parse_2775,
-	parse_2776,
-	parse_2777,
-	parse_2778,
-	parse_2779,
-	parse_2780,
-	parse_2781,
-	parse_2782,
-	parse_2783,
-	parse_2784,
-	parse_2785,
-	parse_2786,
-	parse_2787,
-	parse_2788,
-	parse_2789,
-	parse_2790,
-	parse_2791,
-	parse_2792,
-	parse_2793,
-	parse_2794,
-	parse_2795,
-	parse_2796,
-	parse_2797,
-	parse_2798,
-	parse_2799,
-	parse_2800,
-	parse_2801,
-	parse_2802,
-	parse_2803,
-	parse_2804,
-	parse_2805,
-	parse_2806,
-	parse_2807,
-	parse_2808,
-	parse_2809,
-	parse_2810,
-	parse_2811,
-}
-func parse_0(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001000)&&(((insn >> 16) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_1(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01011)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01011)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_3(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_4(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 21) & 0b11111111) == 0b11010000)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_5(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_6(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_7(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 21) & 0b11111111) == 0b11010000)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_8(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b01011)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_9(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100010)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_10(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01011)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_11(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_12(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_13(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b11111) == 0b11000)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_14(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b11111) == 0b11000)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_15(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_16(insn uint32) bool {
-	return (((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b100)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_17(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_18(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b01)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1000000)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_19(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111) == 0b001)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1000010)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_20(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_21(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_22(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b01)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_23(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111) == 0b001)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_24(insn uint32) bool {
-	return (((insn >> 14) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111) == 0b100011)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_25(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b000)&&(((insn >> 20) & 0b11) == 0b01)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0000001)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_26(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b01000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0000001)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_27(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_28(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_29(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_30(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11011)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_31(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_32(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_33(insn uint32) bool {
-	return (((insn >> 11) & 0b11111) == 0b01010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b000001000)
-}
-
-func parse_34(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0001)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_35(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b01011)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_36(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100010)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_37(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01011)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_38(insn uint32) bool {
-	return (((insn >> 11) & 0b11111) == 0b01011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b000001000)
-}
-
-func parse_39(insn uint32) bool {
-	return (((insn >> 11) & 0b11111) == 0b01011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b000001000)
-}
-
-func parse_40(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11011)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_41(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b000)&&(((insn >> 20) & 0b11) == 0b01)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0000001)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_42(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b01000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0000001)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_43(insn uint32) bool {
-	return (((insn >> 11) & 0b11111) == 0b01010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b000001000)
-}
-
-func parse_44(insn uint32) bool {
-	return (((insn >> 24) & 0b11111) == 0b10000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_45(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_46(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_47(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_48(insn uint32) bool {
-	return (((insn >> 24) & 0b11111) == 0b10000)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_49(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0010)&&(((insn >> 17) & 0b11111) == 0b10100)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01001110)
-}
-
-func parse_50(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b10001)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_51(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0010)&&(((insn >> 17) & 0b11111) == 0b10100)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01001110)
-}
-
-func parse_52(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b10001)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_53(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0011)&&(((insn >> 17) & 0b11111) == 0b10100)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01001110)
-}
-
-func parse_54(insn uint32) bool {
-	return (((insn >> 5) & 0b11111) == 0b00000)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111111111) == 0b10000011100)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_55(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0011)&&(((insn >> 17) & 0b11111) == 0b10100)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01001110)
-}
-
-func parse_56(insn uint32) bool {
-	return (((insn >> 5) & 0b11111) == 0b00000)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111111111) == 0b10000011100)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_57(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_58(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100100)&&(((insn >> 29) & 0b11) == 0b00)
-}
-
-func parse_59(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01010)&&(((insn >> 29) & 0b11) == 0b00)
-}
-
-func parse_60(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_61(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_62(insn uint32) bool {
-	return (((insn >> 18) & 0b1111) == 0b0000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_63(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_64(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0111)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_65(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100100)&&(((insn >> 29) & 0b11) == 0b11)
-}
-
-func parse_66(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01010)&&(((insn >> 29) & 0b11) == 0b11)
-}
-
-func parse_67(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_68(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0110)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_69(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_70(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_71(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_72(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_73(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_74(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_75(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_76(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_77(insn uint32) bool {
-	return (((insn >> 10) & 0b111) == 0b110)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_78(insn uint32) bool {
-	return (((insn >> 10) & 0b111) == 0b111)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_79(insn uint32) bool {
-	return (((insn >> 10) & 0b111) == 0b100)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_80(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b110) == 0b100)&&(((insn >> 8) & 0b1101) == 0b0001)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_81(insn uint32) bool {
-	return (((insn >> 10) & 0b111) == 0b101)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_82(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b110) == 0b110)&&(((insn >> 8) & 0b1101) == 0b0001)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_83(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b010)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 16) & 0b111) == 0b000)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_84(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b0101010)
-}
-
-func parse_85(insn uint32) bool {
-	return (((insn >> 26) & 0b11111) == 0b00101)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_86(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b0101010)
-}
-
-func parse_87(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b111111111) == 0b110011100)
-}
-
-func parse_88(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_89(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_90(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_91(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b000)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_92(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_93(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1001000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_94(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1001010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_95(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b110000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_96(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b110010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_97(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_98(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b111111) == 0b000110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_99(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b100000111000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_100(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_101(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_102(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111111111) == 0b100000111000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_103(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_104(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_105(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b1111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_106(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b011001000)
-}
-
-func parse_107(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b011001000)
-}
-
-func parse_108(insn uint32) bool {
-	return (((insn >> 3) & 0b111) == 0b011)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_109(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b11) == 0b01)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_110(insn uint32) bool {
-	return (((insn >> 3) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010010)
-}
-
-func parse_111(insn uint32) bool {
-	return (((insn >> 3) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010011)
-}
-
-func parse_112(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b01)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b111111111) == 0b000001101)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_113(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b111111111) == 0b000001101)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_114(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100110)&&(((insn >> 29) & 0b11) == 0b01)
-}
-
-func parse_115(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_116(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_117(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_118(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_119(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b011)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_120(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_121(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_122(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_123(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_124(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b010)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_125(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_126(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_127(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_128(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_129(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b011)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_130(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_131(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_132(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_133(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_134(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b010)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_135(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_136(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_137(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_138(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_139(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_140(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_141(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_142(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_143(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b11)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_144(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b11) == 0b11)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_145(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011000)
-}
-
-func parse_146(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_147(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_148(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010010)
-}
-
-func parse_149(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010010)
-}
-
-func parse_150(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010011)
-}
-
-func parse_151(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b11111111111) == 0b11000001101)
-}
-
-func parse_152(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b11111111111) == 0b11000001101)
-}
-
-func parse_153(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_154(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_155(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_156(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_157(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_158(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_159(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_160(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_161(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_162(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_163(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_164(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_165(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011000)
-}
-
-func parse_166(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_167(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_168(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010010)
-}
-
-func parse_169(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010010)
-}
-
-func parse_170(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010011)
-}
-
-func parse_171(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b11111111111) == 0b11000001101)
-}
-
-func parse_172(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b11111111111) == 0b11000001101)
-}
-
-func parse_173(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_174(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_175(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_176(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_177(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b1101)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_178(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b111001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_179(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b111111111) == 0b000001100)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_180(insn uint32) bool {
-	return (((insn >> 1) & 0b11) == 0b00)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b111111111) == 0b000001101)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_181(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b111111111) == 0b000001100)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_182(insn uint32) bool {
-	return (((insn >> 1) & 0b11) == 0b00)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b111111111) == 0b000001101)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_183(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b001)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_184(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b01)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_185(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_186(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b000)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_187(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_188(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1001000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_189(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1001010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_190(insn uint32) bool {
-	return (((insn >> 3) & 0b111) == 0b011)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_191(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_192(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b0001) == 0b0001)&&(((insn >> 19) & 0b1111111111) == 0b0111100000)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_193(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_194(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01010)&&(((insn >> 29) & 0b11) == 0b00)
-}
-
-func parse_195(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_196(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_197(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_198(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01010)&&(((insn >> 29) & 0b11) == 0b11)
-}
-
-func parse_199(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_200(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_201(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_202(insn uint32) bool {
-	return (((insn >> 26) & 0b11111) == 0b00101)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_203(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b00000)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1101011)
-}
-
-func parse_204(insn uint32) bool {
-	return (((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1101011)
-}
-
-func parse_205(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b111111111) == 0b000000100)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_206(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b111111111) == 0b000000100)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_207(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b00000)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1101011)
-}
-
-func parse_208(insn uint32) bool {
-	return (((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1101011)
-}
-
-func parse_209(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 2) & 0b111) == 0b000)&&(((insn >> 21) & 0b111) == 0b001)&&(((insn >> 24) & 0b11111111) == 0b11010100)
-}
-
-func parse_210(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11111111) == 0b01000001)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_211(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11111111) == 0b01000001)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_212(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11111111) == 0b01000001)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_213(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11111111) == 0b01000001)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_214(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11111111) == 0b01100001)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b001001010)
-}
-
-func parse_215(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11111111) == 0b01100001)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b001001010)
-}
-
-func parse_216(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_217(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_218(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_219(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_220(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_221(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_222(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_223(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_224(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b001) == 0b000)&&(((insn >> 8) & 0b1111) == 0b0100)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_225(insn uint32) bool {
-	return (((insn >> 11) & 0b11111) == 0b11011)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00000)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_226(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0010001)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_227(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0010001)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_228(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0010001)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_229(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_230(insn uint32) bool {
-	return (((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b111111) == 0b011010)
-}
-
-func parse_231(insn uint32) bool {
-	return (((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b111111) == 0b011010)
-}
-
-func parse_232(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111) == 0b11010010)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_233(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b11010010)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_234(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111) == 0b11010010)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_235(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b11010010)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_236(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_237(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_238(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_239(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b000)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 16) & 0b111) == 0b000)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_240(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b000)&&(((insn >> 8) & 0b1111) == 0b0101)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_241(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_242(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b10101)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_243(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b10100)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_244(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_245(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b10101)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_246(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b10100)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_247(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b110)&&(((insn >> 8) & 0b1111) == 0b0010)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_248(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b010)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_249(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_250(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00010)&&(((insn >> 16) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_251(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_252(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_253(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00010)&&(((insn >> 16) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_254(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_255(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_256(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_257(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_258(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_259(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_260(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_261(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_262(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_263(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_264(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_265(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_266(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_267(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_268(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_269(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_270(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_271(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_272(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_273(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_274(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_275(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_276(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01010)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_277(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01010)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_278(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_279(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_280(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_281(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_282(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_283(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_284(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_285(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_286(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_287(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_288(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_289(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_290(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_291(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_292(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_293(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_294(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_295(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_296(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_297(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_298(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_299(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_300(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_301(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_302(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_303(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100100)
-}
-
-func parse_304(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_305(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_306(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_307(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000111)&&(((insn >> 16) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_308(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_309(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_310(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_311(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_312(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_313(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_314(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b100)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_315(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b100)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_316(insn uint32) bool {
-	return (((insn >> 13) & 0b111111111) == 0b100001100)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_317(insn uint32) bool {
-	return (((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b01)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_318(insn uint32) bool {
-	return (((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b01)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_319(insn uint32) bool {
-	return (((insn >> 13) & 0b111111111) == 0b101000101)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_320(insn uint32) bool {
-	return (((insn >> 13) & 0b111111111) == 0b100000100)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_321(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_322(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_323(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_324(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_325(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_326(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_327(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_328(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_329(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_330(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_331(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_332(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_333(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_334(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1101)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_335(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_336(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0101)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_337(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_338(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_339(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_340(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_341(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_342(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_343(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_344(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_345(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_346(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_347(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_348(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_349(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_350(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1101)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_351(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_352(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0101)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_353(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_354(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_355(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b100)&&(((insn >> 8) & 0b1111) == 0b0010)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_356(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b11010100)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_357(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b11010100)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_358(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b11010100)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_359(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b11010100)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_360(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b001000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b100101)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_361(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111) == 0b001000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b100101)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_362(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000110)&&(((insn >> 16) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_363(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b01)&&(((insn >> 2) & 0b111) == 0b000)&&(((insn >> 21) & 0b111) == 0b101)&&(((insn >> 24) & 0b11111111) == 0b11010100)
-}
-
-func parse_364(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b10)&&(((insn >> 2) & 0b111) == 0b000)&&(((insn >> 21) & 0b111) == 0b101)&&(((insn >> 24) & 0b11111111) == 0b11010100)
-}
-
-func parse_365(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b11)&&(((insn >> 2) & 0b111) == 0b000)&&(((insn >> 21) & 0b111) == 0b101)&&(((insn >> 24) & 0b11111111) == 0b11010100)
-}
-
-func parse_366(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_367(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_368(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_369(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_370(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_371(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_372(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_373(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1011)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_374(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1011)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_375(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b110)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_376(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b11) == 0b01)&&(((insn >> 7) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_377(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b00000)&&(((insn >> 5) & 0b11111) == 0b11111)&&(((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1111) == 0b0101)&&(((insn >> 25) & 0b1111111) == 0b1101011)
-}
-
-func parse_378(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 7) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_379(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b11) == 0b01)&&(((insn >> 7) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111111111111111111) == 0b11010101000000110011)
-}
-
-func parse_380(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b0000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b11110000)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_381(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b0000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b01110000)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_382(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b0001)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b01110000)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_383(insn uint32) bool {
-	return (((insn >> 14) & 0b111) == 0b011)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b111)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_384(insn uint32) bool {
-	return (((insn >> 10) & 0b111111111111) == 0b100000001110)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_385(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_386(insn uint32) bool {
-	return (((insn >> 18) & 0b11111111111111) == 0b00000101110000)
-}
-
-func parse_387(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001001)&&(((insn >> 21) & 0b11111111111) == 0b00000101001)
-}
-
-func parse_388(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01010)&&(((insn >> 29) & 0b11) == 0b10)
-}
-
-func parse_389(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b111111111) == 0b110011100)
-}
-
-func parse_390(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_391(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_392(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100100)&&(((insn >> 29) & 0b11) == 0b10)
-}
-
-func parse_393(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01010)&&(((insn >> 29) & 0b11) == 0b10)
-}
-
-func parse_394(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_395(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_396(insn uint32) bool {
-	return (((insn >> 18) & 0b1111) == 0b0000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_397(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_398(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_399(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0111)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_400(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_401(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_402(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0110)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_403(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b00000)&&(((insn >> 5) & 0b11111) == 0b11111)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b111) == 0b100)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1101011)
-}
-
-func parse_404(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b11111) == 0b11111)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b111) == 0b100)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1101011)
-}
-
-func parse_405(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b000)&&(((insn >> 8) & 0b1111) == 0b0010)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_406(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b111111) == 0b101110)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_407(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11111111111) == 0b00000101011)
-}
-
-func parse_408(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11111111111) == 0b00000101001)
-}
-
-func parse_409(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001001)&&(((insn >> 20) & 0b111111111111) == 0b000001010110)
-}
-
-func parse_410(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111) == 0b100111)&&(((insn >> 29) & 0b11) == 0b00)
-}
-
-func parse_411(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b010)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_412(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_413(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b010)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_414(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_415(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b100)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_416(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_417(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_418(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b11) == 0b01)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_419(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_420(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_421(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_422(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_423(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_424(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_425(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_426(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_427(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_428(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_429(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_430(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b010)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_431(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_432(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_433(insn uint32) bool {
-	return (((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_434(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b000)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_435(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_436(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1000000)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_437(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1001000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_438(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1000010)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_439(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1001010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_440(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0110)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_441(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01101)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_442(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01101)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_443(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b010)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_444(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_445(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_446(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_447(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_448(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 13) & 0b11) == 0b11)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_449(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 17) & 0b11111) == 0b00000)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_450(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_451(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_452(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b110000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_453(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b110010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_454(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_455(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_456(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_457(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_458(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_459(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_460(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_461(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_462(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_463(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0100)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_464(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0100)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_465(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0100)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_466(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0100)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_467(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0100)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_468(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0100)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_469(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_470(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_471(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_472(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_473(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_474(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_475(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_476(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_477(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_478(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_479(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_480(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_481(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_482(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_483(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_484(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_485(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_486(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_487(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_488(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_489(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_490(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_491(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 13) & 0b11) == 0b10)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_492(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_493(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_494(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_495(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_496(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_497(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_498(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_499(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01110)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_500(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_501(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01110)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_502(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_503(insn uint32) bool {
-	return (((insn >> 0) & 0b111) == 0b000)&&(((insn >> 3) & 0b10) == 0b00)&&(((insn >> 10) & 0b1111) == 0b1000)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_504(insn uint32) bool {
-	return (((insn >> 0) & 0b111) == 0b000)&&(((insn >> 3) & 0b10) == 0b10)&&(((insn >> 10) & 0b1111) == 0b1000)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_505(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 20) & 0b11) == 0b01)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_506(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_507(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 17) & 0b1111) == 0b0001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_508(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000110100000111000)
-}
-
-func parse_509(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b100000111000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_510(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_511(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_512(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_513(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_514(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_515(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_516(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11100)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_517(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_518(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11100)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_519(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_520(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b100)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_521(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11100)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_522(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_523(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11100)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_524(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_525(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b101)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_526(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_527(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000110100000111000)
-}
-
-func parse_528(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_529(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_530(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_531(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_532(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_533(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_534(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b000)&&(((insn >> 19) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_535(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_536(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_537(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_538(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_539(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b001)&&(((insn >> 19) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_540(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_541(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111111111) == 0b100000111000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_542(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_543(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_544(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_545(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_546(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b000)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_547(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_548(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_549(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_550(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_551(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_552(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_553(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b001)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_554(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_555(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_556(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_557(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_558(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b000)&&(((insn >> 19) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_559(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_560(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_561(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_562(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_563(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b001)&&(((insn >> 19) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_564(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_565(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_566(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10110)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_567(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_568(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11111)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_569(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11111)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_570(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_571(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_572(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_573(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_574(insn uint32) bool {
-	return (((insn >> 16) & 0b111) == 0b000)&&(((insn >> 19) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_575(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b000)&&(((insn >> 19) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_576(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000100100001111000)
-}
-
-func parse_577(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000100110001111000)
-}
-
-func parse_578(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_579(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_580(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_581(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_582(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_583(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_584(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_585(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11111)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_586(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11111)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_587(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_588(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_589(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_590(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_591(insn uint32) bool {
-	return (((insn >> 16) & 0b111) == 0b001)&&(((insn >> 19) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_592(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b001)&&(((insn >> 19) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_593(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000100100001111000)
-}
-
-func parse_594(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000100110001111000)
-}
-
-func parse_595(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_596(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_597(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_598(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_599(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_600(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_601(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_602(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b111)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_603(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_604(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1111) == 0b0001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_605(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b110)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_606(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b110)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_607(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b011001000)
-}
-
-func parse_608(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b011001000)
-}
-
-func parse_609(insn uint32) bool {
-	return (((insn >> 3) & 0b111) == 0b001)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_610(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_611(insn uint32) bool {
-	return (((insn >> 3) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010010)
-}
-
-func parse_612(insn uint32) bool {
-	return (((insn >> 3) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010011)
-}
-
-func parse_613(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b111111111) == 0b000001101)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_614(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b111111111) == 0b000001101)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_615(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b111) == 0b111)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b111)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_616(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b101110)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_617(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b110)&&(((insn >> 19) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_618(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_619(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_620(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_621(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b110)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_622(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_623(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_624(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_625(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_626(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_627(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_628(insn uint32) bool {
-	return (((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_629(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b011)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_630(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b000)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_631(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_632(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_633(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_634(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_635(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_636(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_637(insn uint32) bool {
-	return (((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_638(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b010)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_639(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01100)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_640(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01100)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_641(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b000)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_642(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_643(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_644(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_645(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01100)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_646(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01100)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_647(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_648(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_649(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_650(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b110)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_651(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_652(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_653(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_654(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_655(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_656(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_657(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b110)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_658(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_659(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b01)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_660(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_661(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_662(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_663(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_664(insn uint32) bool {
-	return (((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_665(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b011)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_666(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b000)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_667(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_668(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b11)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_669(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_670(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0100)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_671(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_672(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 7) & 0b111) == 0b010)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_673(insn uint32) bool {
-	return (((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_674(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b010)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_675(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01100)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_676(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01100)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_677(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b000)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_678(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_679(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_680(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_681(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01100)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_682(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01100)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_683(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_684(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_685(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_686(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b110)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_687(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_688(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_689(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_690(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_691(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_692(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_693(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b01)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_694(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b01)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_695(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b01)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_696(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b01)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_697(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b001)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_698(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_699(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_700(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_701(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_702(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_703(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_704(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_705(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_706(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_707(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_708(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_709(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_710(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_711(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_712(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_713(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_714(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_715(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_716(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_717(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_718(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_719(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b1101)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_720(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b1001)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_721(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011000)
-}
-
-func parse_722(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_723(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_724(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010010)
-}
-
-func parse_725(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010010)
-}
-
-func parse_726(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010011)
-}
-
-func parse_727(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b11111111111) == 0b11000001101)
-}
-
-func parse_728(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b11111111111) == 0b11000001101)
-}
-
-func parse_729(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_730(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_731(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_732(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_733(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b01)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_734(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b01)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_735(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b01)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_736(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b01)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_737(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b001)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_738(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_739(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_740(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_741(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_742(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_743(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_744(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_745(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_746(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_747(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_748(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_749(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_750(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_751(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_752(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_753(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_754(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_755(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_756(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_757(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_758(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_759(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b1101)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_760(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b1001)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_761(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011000)
-}
-
-func parse_762(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_763(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_764(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010010)
-}
-
-func parse_765(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010010)
-}
-
-func parse_766(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010011)
-}
-
-func parse_767(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b11111111111) == 0b11000001101)
-}
-
-func parse_768(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b11111111111) == 0b11000001101)
-}
-
-func parse_769(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_770(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_771(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_772(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b011001001)
-}
-
-func parse_773(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b111001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_774(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b111001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_775(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b111111111) == 0b000001101)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_776(insn uint32) bool {
-	return (((insn >> 1) & 0b11) == 0b00)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b111111111) == 0b000001100)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_777(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b111111111) == 0b000000100)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_778(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b111111111) == 0b000000110)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_779(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b111111111) == 0b000001101)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_780(insn uint32) bool {
-	return (((insn >> 1) & 0b11) == 0b00)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b111111111) == 0b000001100)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_781(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b111111111) == 0b000000100)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_782(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b111111111) == 0b000000110)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_783(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 19) & 0b1111111111) == 0b0111100000)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_784(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 19) & 0b1111111111) == 0b0111100000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_785(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_786(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b110) == 0b110)&&(((insn >> 19) & 0b10) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_787(insn uint32) bool {
-	return (((insn >> 5) & 0b11111) == 0b00000)&&(((insn >> 10) & 0b111) == 0b100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_788(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_789(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_790(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_791(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_792(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_793(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_794(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b011)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_795(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_796(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_797(insn uint32) bool {
-	return (((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_798(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b001)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_799(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b01)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_800(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_801(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_802(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100100)
-}
-
-func parse_803(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_804(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_805(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_806(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_807(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b011)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_808(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_809(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b011)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_810(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_811(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111) == 0b101)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_812(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_813(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_814(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b11) == 0b10)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_815(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_816(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_817(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_818(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_819(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_820(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_821(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_822(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_823(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_824(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_825(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_826(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_827(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00111)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_828(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b111)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_829(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_830(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b111)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_831(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_832(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b11)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_833(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11111)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_834(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_835(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0011)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_836(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_837(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b11) == 0b01)&&(((insn >> 17) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b10) == 0b00)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_838(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_839(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 17) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b10) == 0b00)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_840(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_841(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b11) == 0b11)&&(((insn >> 17) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b10) == 0b00)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_842(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_843(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b11) == 0b10)&&(((insn >> 17) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b10) == 0b00)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_844(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_845(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_846(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b111) == 0b100)&&(((insn >> 18) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_847(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b111000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_848(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111111) == 0b111000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b111)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_849(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_850(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_851(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_852(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_853(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_854(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_855(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_856(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_857(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_858(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b111) == 0b111)&&(((insn >> 18) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_859(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_860(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_861(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 18) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_862(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b111000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_863(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111111) == 0b111000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b111)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_864(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_865(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_866(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b111) == 0b000)&&(((insn >> 18) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_867(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b111000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_868(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111111) == 0b111000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b111)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_869(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_870(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_871(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b111) == 0b001)&&(((insn >> 18) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_872(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b111000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_873(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111111) == 0b111000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b111)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_874(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_875(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_876(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b111) == 0b110)&&(((insn >> 18) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_877(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_878(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b1100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_879(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b111) == 0b011)&&(((insn >> 18) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_880(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_881(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_882(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_883(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_884(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b00111)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_885(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b111)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_886(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_887(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b111)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_888(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_889(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b11)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_890(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b100)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_891(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11111)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_892(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_893(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b10000)&&(((insn >> 15) & 0b11) == 0b11)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_894(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0011)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_895(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b111) == 0b010)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_896(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_897(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_898(insn uint32) bool {
-	return (((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_899(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b000)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_900(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_901(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1000000)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_902(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1001000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_903(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1000010)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_904(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1001010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_905(insn uint32) bool {
-	return (((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_906(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b001)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_907(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b100000)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_908(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b01)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_909(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_910(insn uint32) bool {
-	return (((insn >> 3) & 0b111) == 0b001)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_911(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b011)&&(((insn >> 8) & 0b1111) == 0b0010)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_912(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b11111111111) == 0b01000111110)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b11111) == 0b11011)
-}
-
-func parse_913(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 12) & 0b111) == 0b001)&&(((insn >> 15) & 0b11111111111) == 0b01000111110)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b11111) == 0b11011)
-}
-
-func parse_914(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_915(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_916(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_917(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_918(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 2) & 0b111) == 0b000)&&(((insn >> 21) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b11010100)
-}
-
-func parse_919(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b10)&&(((insn >> 2) & 0b111) == 0b000)&&(((insn >> 21) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b11010100)
-}
-
-func parse_920(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_921(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_922(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_923(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_924(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_925(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_926(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_927(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1011)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_928(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1011)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_929(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b010000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_930(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b010010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_931(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b010001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_932(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b010011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_933(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b01110000)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_934(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b0011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b01110000)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_935(insn uint32) bool {
-	return (((insn >> 10) & 0b111111111111) == 0b100100001110)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_936(insn uint32) bool {
-	return (((insn >> 10) & 0b111111111111) == 0b110100001110)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_937(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_938(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b11) == 0b10)&&(((insn >> 7) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_939(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_940(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b10001)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_941(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_942(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b10001)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_943(insn uint32) bool {
-	return (((insn >> 12) & 0b0010) == 0b0010)&&(((insn >> 16) & 0b111111) == 0b000000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_944(insn uint32) bool {
-	return (((insn >> 12) & 0b0010) == 0b0010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011001)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_945(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_946(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_947(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_948(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_949(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_950(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_951(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_952(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_953(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_954(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_955(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_956(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_957(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b000)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_958(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b000)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_959(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b001)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_960(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b001)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_961(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b000)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_962(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b000)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_963(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b001)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_964(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b001)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_965(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_966(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_967(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_968(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11100000)
-}
-
-func parse_969(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_970(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_971(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_972(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_973(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_974(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_975(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_976(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_977(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_978(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b111)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_979(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 20) & 0b111) == 0b001)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_980(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b111)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_981(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_982(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_983(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_984(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_985(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_986(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11100000)
-}
-
-func parse_987(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_988(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_989(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_990(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_991(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_992(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_993(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_994(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_995(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_996(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_997(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b010)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_998(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b011)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_999(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b011)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1000(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b010)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1001(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b011)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1002(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b011)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1003(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b100001001)
-}
-
-func parse_1004(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1005(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1006(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1007(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1008(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1009(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11100000)
-}
-
-func parse_1010(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11111111111) == 0b11000100000)
-}
-
-func parse_1011(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b11100001110)
-}
-
-func parse_1012(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1013(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1014(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1015(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1016(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1017(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1018(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1019(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1020(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1021(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1022(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1023(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1024(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1025(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1026(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1027(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1028(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1029(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1030(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1031(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1032(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1033(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1034(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1035(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1036(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1037(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1038(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1039(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1040(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1041(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1042(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1043(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1044(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1045(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1046(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1047(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1048(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b111)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1049(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b110)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1050(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b110)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1051(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b111)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1052(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b110)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1053(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b110)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1054(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1055(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1056(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1057(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1058(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1059(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b100)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1060(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b100)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1061(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b100)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1062(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b100)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1063(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b100001001)
-}
-
-func parse_1064(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1065(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1066(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1067(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1068(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1069(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1070(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b010)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1071(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b010)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1072(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1073(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1074(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1075(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1076(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_1077(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_1078(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_1079(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_1080(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_1081(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_1082(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_1083(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_1084(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1085(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1086(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b101)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1087(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b101)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1088(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 20) & 0b111) == 0b001)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1089(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b101)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1090(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b101)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1091(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1092(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b100001010)
-}
-
-func parse_1093(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1094(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1095(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1096(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1097(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1098(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11100000)
-}
-
-func parse_1099(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 16) & 0b111111) == 0b000000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1100(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011001)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1101(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1102(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1103(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1104(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1105(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1106(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1107(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1108(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1109(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b111) == 0b001)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1110(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1111(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1112(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1113(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1114(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1115(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 16) & 0b111111) == 0b000000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1116(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011001)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1117(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1118(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1119(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1120(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1121(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1122(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1123(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1124(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1125(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b111) == 0b001)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1126(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1127(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1128(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1129(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1130(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1131(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b111111) == 0b000000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1132(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011001)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1133(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1134(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1135(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1136(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1137(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1138(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1139(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1140(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1141(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b111) == 0b001)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1142(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1143(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1144(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1145(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1146(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1147(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1148(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1149(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1150(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1151(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1152(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b100)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1153(insn uint32) bool {
-	return (((insn >> 10) & 0b111111111111) == 0b000000000010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b011)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1154(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b100)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1155(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b100)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1156(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b01) == 0b01)&&(((insn >> 24) & 0b111111) == 0b011101)
-}
-
-func parse_1157(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1158(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1159(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1160(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1161(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1162(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1163(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1164(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1165(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1166(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1167(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1168(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1169(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1170(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1171(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1172(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1173(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1174(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1175(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1176(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1177(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1178(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1179(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b000)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1180(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b000)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1181(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b001)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1182(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b001)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1183(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1184(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1185(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1186(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1187(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b111)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1188(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1189(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1190(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1191(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1192(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1193(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1194(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b010)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1195(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b011)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1196(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b011)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1197(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b100001001)
-}
-
-func parse_1198(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1199(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1200(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1201(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1202(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1203(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1204(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1205(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b111)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1206(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b110)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1207(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b110)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1208(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1209(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1210(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1211(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1212(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1213(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b100)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1214(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b100)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1215(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b100001001)
-}
-
-func parse_1216(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1217(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1218(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1219(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1220(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1221(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1222(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b010)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1223(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1224(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1225(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1226(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1227(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1228(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1229(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b101)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1230(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b101)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1231(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b100001010)
-}
-
-func parse_1232(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1233(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1234(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1235(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1236(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1237(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_1238(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_1239(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1110) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b010)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1240(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1241(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1242(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1243(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b000)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1244(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b000)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1245(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b001)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1246(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b001)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1247(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b111)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1248(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b010)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1249(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b011)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1250(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b011)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1251(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b111)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1252(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b110)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1253(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b110)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1254(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b100)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1255(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b100)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1256(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b010)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1257(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b111) == 0b101)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1258(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b111) == 0b101)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1259(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b000)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b101)
-}
-
-func parse_1260(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b000)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b01) == 0b00)
-}
-
-func parse_1261(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_1262(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_1263(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_1264(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_1265(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_1266(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_1267(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_1268(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_1269(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1270(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1271(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b111) == 0b000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1272(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1273(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_1274(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_1275(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_1276(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_1277(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_1278(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_1279(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_1280(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_1281(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1282(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b111) == 0b000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1283(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1284(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_1285(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_1286(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_1287(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_1288(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_1289(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_1290(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_1291(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_1292(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1293(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1294(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b111) == 0b000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1295(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1296(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1297(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1298(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1299(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1300(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1301(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_1302(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000100)
-}
-
-func parse_1303(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_1304(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000000)
-}
-
-func parse_1305(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_1306(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010100)
-}
-
-func parse_1307(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_1308(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001000)
-}
-
-func parse_1309(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1310(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1311(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b111) == 0b000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1312(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1010010)
-}
-
-func parse_1313(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b001)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b101)
-}
-
-func parse_1314(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b011)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b101)
-}
-
-func parse_1315(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b010)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b101)
-}
-
-func parse_1316(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b001)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b01) == 0b00)
-}
-
-func parse_1317(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b011)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b01) == 0b00)
-}
-
-func parse_1318(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b010)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b01) == 0b00)
-}
-
-func parse_1319(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b001)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1320(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b011)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1321(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111) == 0b010)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1322(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b01) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b111)
-}
-
-func parse_1323(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b01) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b111)
-}
-
-func parse_1324(insn uint32) bool {
-	return (((insn >> 22) & 0b01) == 0b01)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b111)
-}
-
-func parse_1325(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1326(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1327(insn uint32) bool {
-	return (((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1328(insn uint32) bool {
-	return (((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_1329(insn uint32) bool {
-	return (((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)&&(((insn >> 30) & 0b10) == 0b00)
-}
-
-func parse_1330(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 22) & 0b1111111111) == 0b1000010110)
-}
-
-func parse_1331(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b01) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b111)
-}
-
-func parse_1332(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1333(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 22) & 0b1111111111) == 0b1000010110)
-}
-
-func parse_1334(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b111111) == 0b000000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1110000100)
-}
-
-func parse_1335(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b100000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b111)&&(((insn >> 20) & 0b11) == 0b01)&&(((insn >> 22) & 0b11111111) == 0b10000100)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1336(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1337(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1338(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1339(insn uint32) bool {
-	return (((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1340(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1341(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1342(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1343(insn uint32) bool {
-	return (((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1344(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1345(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1346(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1347(insn uint32) bool {
-	return (((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1348(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1349(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1350(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1351(insn uint32) bool {
-	return (((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1352(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1353(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1354(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1355(insn uint32) bool {
-	return (((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1356(insn uint32) bool {
-	return (((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1357(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1358(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1359(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1360(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1361(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1362(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b100)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1363(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b100)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1364(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b100)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1365(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1366(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1367(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1368(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1369(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1370(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1371(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1372(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1373(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1374(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1375(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1376(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1377(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1378(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1379(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1380(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b01) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b111)
-}
-
-func parse_1381(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1382(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1383(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1384(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1385(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b10) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1386(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1387(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1388(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_1389(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1390(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1391(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1392(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1393(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1394(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1395(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1396(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1397(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_1398(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1399(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1400(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1401(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1402(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1403(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1404(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_1405(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 10) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1111111111111) == 0b1100000010001)
-}
-
-func parse_1406(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 10) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b11111111111) == 0b00000010011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1407(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 10) & 0b11) == 0b00)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1111111111111) == 0b1100000010001)
-}
-
-func parse_1408(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 10) & 0b11) == 0b00)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b1111111111) == 0b0000001001)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1409(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1111111111111) == 0b1100000011001)
-}
-
-func parse_1410(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 10) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b1111111111111) == 0b1100000010001)
-}
-
-func parse_1411(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 10) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b11111111111) == 0b00000010011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1412(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 10) & 0b11) == 0b00)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b1111111111111) == 0b1100000010001)
-}
-
-func parse_1413(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 10) & 0b11) == 0b00)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b1111111111) == 0b0000001001)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1414(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b1111111111111) == 0b1100000011001)
-}
-
-func parse_1415(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1416(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111) == 0b11011)&&(((insn >> 29) & 0b11) == 0b00)
-}
-
-func parse_1417(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1418(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1419(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1420(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1421(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1422(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1423(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1424(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1425(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1426(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1427(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1428(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1429(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1430(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1431(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1432(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1433(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1434(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 7) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1435(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 7) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1436(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 7) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1437(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1438(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b11111111111111111) == 0b11000000000001100)
-}
-
-func parse_1439(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b011)&&(((insn >> 15) & 0b11111111111111111) == 0b11000000000001100)
-}
-
-func parse_1440(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00001)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1441(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00001)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1442(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00001)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1443(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00001)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1444(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b00001)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1445(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000100)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1446(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000100)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1447(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000100)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1448(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000100)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1449(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b1111) == 0b0100)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1450(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b1111) == 0b0100)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1451(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b1111) == 0b0100)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1452(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b111111) == 0b000100)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1453(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b111111111111111) == 0b000000000001000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1454(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b011)&&(((insn >> 15) & 0b111111111111111) == 0b000000000001000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1455(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1456(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1457(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1458(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1459(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b00000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1460(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1461(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1462(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1463(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1464(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 7) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1465(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 7) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1466(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 7) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1467(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 16) & 0b111111) == 0b000110)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1468(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b11111111111111111) == 0b11000000000001100)
-}
-
-func parse_1469(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111) == 0b011)&&(((insn >> 15) & 0b11111111111111111) == 0b11000000000001100)
-}
-
-func parse_1470(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00001)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1471(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00001)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1472(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00001)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1473(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00001)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1474(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b00001)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000000)
-}
-
-func parse_1475(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 19) & 0b1111111111) == 0b0111100000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1476(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100101)&&(((insn >> 29) & 0b11) == 0b11)
-}
-
-func parse_1477(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100101)&&(((insn >> 29) & 0b11) == 0b00)
-}
-
-func parse_1478(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1479(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b101111)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1480(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b1111)&&(((insn >> 10) & 0b11) == 0b00)&&(((insn >> 15) & 0b11111111111111111) == 0b11000000010011000)
-}
-
-func parse_1481(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b1111)&&(((insn >> 10) & 0b11) == 0b00)&&(((insn >> 15) & 0b11111111111111111) == 0b11000000010011100)
-}
-
-func parse_1482(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100101)&&(((insn >> 29) & 0b11) == 0b10)
-}
-
-func parse_1483(insn uint32) bool {
-	return (((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1111111111) == 0b1101010101)
-}
-
-func parse_1484(insn uint32) bool {
-	return (((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_1485(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1486(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_1487(insn uint32) bool {
-	return (((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_1488(insn uint32) bool {
-	return (((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010101)
-}
-
-func parse_1489(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111) == 0b11011)&&(((insn >> 29) & 0b11) == 0b00)
-}
-
-func parse_1490(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1491(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1492(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0100)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1493(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b110)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1494(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1495(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b111110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1496(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b111110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1497(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b111110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1498(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 19) & 0b1111111111) == 0b0111100000)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1499(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 9) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1500(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 9) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1501(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1502(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01011)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1503(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b01011)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1504(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1505(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1506(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b000)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_1507(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1508(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1509(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1510(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1511(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1512(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01010)&&(((insn >> 29) & 0b11) == 0b01)
-}
-
-func parse_1513(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1514(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1515(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0111)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1516(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b0001) == 0b0001)&&(((insn >> 19) & 0b1111111111) == 0b0111100000)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1517(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1518(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100100)&&(((insn >> 29) & 0b11) == 0b01)
-}
-
-func parse_1519(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01010)&&(((insn >> 29) & 0b11) == 0b01)
-}
-
-func parse_1520(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1521(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1522(insn uint32) bool {
-	return (((insn >> 18) & 0b1111) == 0b0000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1523(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1524(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1525(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0110)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1526(insn uint32) bool {
-	return (((insn >> 10) & 0b111) == 0b010)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1527(insn uint32) bool {
-	return (((insn >> 10) & 0b111) == 0b011)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1528(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001100)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1529(insn uint32) bool {
-	return (((insn >> 10) & 0b111) == 0b000)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1530(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b110) == 0b000)&&(((insn >> 8) & 0b1101) == 0b0001)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_1531(insn uint32) bool {
-	return (((insn >> 10) & 0b111) == 0b001)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1532(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b110) == 0b010)&&(((insn >> 8) & 0b1101) == 0b0001)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_1533(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111111111) == 0b10000001110)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1534(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111111111) == 0b10000001110)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1535(insn uint32) bool {
-	return (((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b111111111111) == 0b011000111001)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1536(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b011000110000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1537(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111) == 0b0001110)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1538(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111) == 0b0001110)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1539(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111) == 0b0001110)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1540(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111) == 0b0001110)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1541(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111) == 0b1001110)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1542(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111) == 0b1001110)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1543(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111) == 0b1001110)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1544(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111) == 0b1001110)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1545(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1546(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1547(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1548(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1549(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1550(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1551(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1552(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b011001110001)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1553(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1554(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1555(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1000010111)
-}
-
-func parse_1556(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1557(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b100001000)
-}
-
-func parse_1558(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110001000)
-}
-
-func parse_1559(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11000100011)
-}
-
-func parse_1560(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1561(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1562(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1000010111)
-}
-
-func parse_1563(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1564(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b100001000)
-}
-
-func parse_1565(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110001000)
-}
-
-func parse_1566(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11000100011)
-}
-
-func parse_1567(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1568(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1569(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1000010111)
-}
-
-func parse_1570(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1571(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b100001000)
-}
-
-func parse_1572(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110001000)
-}
-
-func parse_1573(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11000100011)
-}
-
-func parse_1574(insn uint32) bool {
-	return (((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1575(insn uint32) bool {
-	return (((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1576(insn uint32) bool {
-	return (((insn >> 0) & 0b11000) == 0b11000)&&(((insn >> 10) & 0b11) == 0b10)&&(((insn >> 13) & 0b010) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1577(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1578(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1579(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1100010)
-}
-
-func parse_1580(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1000010111)
-}
-
-func parse_1581(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1000010)
-}
-
-func parse_1582(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b100001000)
-}
-
-func parse_1583(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110001000)
-}
-
-func parse_1584(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11000100011)
-}
-
-func parse_1585(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b001)&&(((insn >> 8) & 0b1111) == 0b0010)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_1586(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1587(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 14) & 0b111111) == 0b000011)&&(((insn >> 20) & 0b11) == 0b01)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b100101)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1588(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b111000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b01100)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1589(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111111) == 0b000001)&&(((insn >> 10) & 0b111111111111) == 0b100000011110)&&(((insn >> 24) & 0b111111) == 0b100101)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1590(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b111000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b01100)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1591(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b010000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111111111111111) == 0b000001010011000)
-}
-
-func parse_1592(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b010000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b111111111111111) == 0b000001010011000)
-}
-
-func parse_1593(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1594(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1595(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1596(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11001110011)
-}
-
-func parse_1597(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1598(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 22) & 0b11) == 0b01)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1599(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_1600(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b1001)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1601(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1602(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1603(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1604(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1605(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1606(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1607(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1608(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1609(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1610(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1611(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1612(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1613(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1614(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1615(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1616(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1617(insn uint32) bool {
-	return (((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b111111111111) == 0b011001111100)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1618(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b011000111100)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1619(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b011000111100)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1620(insn uint32) bool {
-	return (((insn >> 11) & 0b11111) == 0b01011)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1111) == 0b1111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b000001001)
-}
-
-func parse_1621(insn uint32) bool {
-	return (((insn >> 11) & 0b11111) == 0b01010)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1111) == 0b1111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b000001001)
-}
-
-func parse_1622(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b00000)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1101011)
-}
-
-func parse_1623(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b11111) == 0b11111)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1101011)
-}
-
-func parse_1624(insn uint32) bool {
-	return (((insn >> 10) & 0b10) == 0b10)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_1625(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1111) == 0b0000)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1626(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_1627(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0000)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1628(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1629(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0000)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1630(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b110100010000)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1631(insn uint32) bool {
-	return (((insn >> 10) & 0b111111111111) == 0b111000001110)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1632(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1001)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1633(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1001)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1634(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b1001)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1635(insn uint32) bool {
-	return (((insn >> 13) & 0b111111111) == 0b101110100)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1636(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010000)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1637(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_1638(insn uint32) bool {
-	return (((insn >> 0) & 0b11000) == 0b11000)&&(((insn >> 10) & 0b11) == 0b10)&&(((insn >> 13) & 0b010) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1639(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1640(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_1641(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_1642(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1643(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1644(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1645(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1646(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1647(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1648(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1649(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1650(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1651(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b001)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1652(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1653(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1654(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1655(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1656(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b00010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1657(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1658(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1659(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1660(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1661(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1662(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00011)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1663(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0000)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1664(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1665(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1666(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1667(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b11) == 0b11)&&(((insn >> 7) & 0b1) == 0b1)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_1668(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 21) & 0b11111111) == 0b11010000)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_1669(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1670(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1671(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 21) & 0b11111111) == 0b11010000)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_1672(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100110)&&(((insn >> 29) & 0b11) == 0b00)
-}
-
-func parse_1673(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b110001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1674(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b110011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1675(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1676(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1677(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1678(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1679(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1680(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1681(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1682(insn uint32) bool {
-	return (((insn >> 16) & 0b111) == 0b010)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_1683(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b010)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_1684(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000100100010111000)
-}
-
-func parse_1685(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000100110010111000)
-}
-
-func parse_1686(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_1687(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_1688(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_1689(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_1690(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_1691(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_1692(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_1693(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_1694(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0101)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1695(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0101)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1696(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1697(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b0010)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1698(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11001)&&(((insn >> 21) & 0b11111111111) == 0b01000100000)
-}
-
-func parse_1699(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11001)&&(((insn >> 21) & 0b11111111111) == 0b01000100100)
-}
-
-func parse_1700(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1701(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1702(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1703(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_1704(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_1705(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b1111111111) == 0b0000010110)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1706(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b1111111111) == 0b0000010111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1707(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b111111111) == 0b000001111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1708(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b111111111) == 0b000001111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1709(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_1710(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_1711(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_1712(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_1713(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b1111111) == 0b0000010)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1714(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 23) & 0b1111111) == 0b0000010)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1715(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1716(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_1717(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 13) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1718(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 13) & 0b11111) == 0b01100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1719(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 9) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1720(insn uint32) bool {
-	return (((insn >> 14) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1721(insn uint32) bool {
-	return (((insn >> 0) & 0b1111) == 0b1101)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111) == 0b0010)&&(((insn >> 15) & 0b111111) == 0b000000)&&(((insn >> 21) & 0b11111111) == 0b11010000)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1722(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b1111111111) == 0b1100100100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b100101)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_1723(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b0011) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_1724(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b0011) == 0b0010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_1725(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b0011) == 0b0001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_1726(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b0011) == 0b0011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_1727(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b0011) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_1728(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b0011) == 0b0010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_1729(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b0011) == 0b0001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_1730(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b0011) == 0b0011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)
-}
-
-func parse_1731(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b100)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_1732(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b101)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_1733(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01011110)
-}
-
-func parse_1734(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00000)&&(((insn >> 17) & 0b11111) == 0b10100)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01011110)
-}
-
-func parse_1735(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01011110)
-}
-
-func parse_1736(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01011110)
-}
-
-func parse_1737(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01011110)
-}
-
-func parse_1738(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00001)&&(((insn >> 17) & 0b11111) == 0b10100)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01011110)
-}
-
-func parse_1739(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b11) == 0b10)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01011110)
-}
-
-func parse_1740(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b11) == 0b10)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01011110)
-}
-
-func parse_1741(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00010)&&(((insn >> 17) & 0b11111) == 0b10100)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01011110)
-}
-
-func parse_1742(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01011110)
-}
-
-func parse_1743(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11001110011)
-}
-
-func parse_1744(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11001110011)
-}
-
-func parse_1745(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b11111111111111111111) == 0b11001110110000001000)
-}
-
-func parse_1746(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11001110011)
-}
-
-func parse_1747(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1748(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1749(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b01010)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1750(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b01010)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1751(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10011)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1752(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1753(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_1754(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_1755(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1756(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1757(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1758(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b01010)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1759(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b01010)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1760(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1761(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11001110011)
-}
-
-func parse_1762(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11001110011)
-}
-
-func parse_1763(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110011100)
-}
-
-func parse_1764(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b11111111111) == 0b11001110010)
-}
-
-func parse_1765(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b11111111111) == 0b11001110010)
-}
-
-func parse_1766(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b11111111111) == 0b11001110010)
-}
-
-func parse_1767(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b11111111111) == 0b11001110010)
-}
-
-func parse_1768(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b11111111111111111111) == 0b11001110110000001000)
-}
-
-func parse_1769(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b10001)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1770(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b00)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11001110011)
-}
-
-func parse_1771(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1772(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11011)&&(((insn >> 29) & 0b11) == 0b00)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1773(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1774(insn uint32) bool {
-	return (((insn >> 18) & 0b1111) == 0b0000)&&(((insn >> 22) & 0b1111111) == 0b1000111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_1775(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1776(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1777(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1778(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1779(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b011000)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_1780(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b001)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1781(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1782(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1783(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1784(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1785(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1786(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1787(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b11)&&(((insn >> 2) & 0b111) == 0b000)&&(((insn >> 21) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b11010100)
-}
-
-func parse_1788(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1789(insn uint32) bool {
-	return (((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 22) & 0b1111111) == 0b1000111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_1790(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1791(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1792(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1793(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1794(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b011010)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_1795(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b001)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1796(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1797(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1798(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1799(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1800(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1801(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1802(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1803(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1804(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011100)
-}
-
-func parse_1805(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_1806(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_1807(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010110)
-}
-
-func parse_1808(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010110)
-}
-
-func parse_1809(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010111)
-}
-
-func parse_1810(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b11111111111) == 0b11000001111)
-}
-
-func parse_1811(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b11111111111) == 0b11000001111)
-}
-
-func parse_1812(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1813(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1814(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1815(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010000)
-}
-
-func parse_1816(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011000)
-}
-
-func parse_1817(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_1818(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_1819(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_1820(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_1821(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_1822(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_1823(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_1824(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_1825(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_1826(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1827(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1828(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1829(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1830(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1831(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011100)
-}
-
-func parse_1832(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_1833(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_1834(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010110)
-}
-
-func parse_1835(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010110)
-}
-
-func parse_1836(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010111)
-}
-
-func parse_1837(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b11111111111) == 0b11000001111)
-}
-
-func parse_1838(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b11111111111) == 0b11000001111)
-}
-
-func parse_1839(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1840(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1841(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1842(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010000)
-}
-
-func parse_1843(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011000)
-}
-
-func parse_1844(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_1845(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_1846(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_1847(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_1848(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_1849(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_1850(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_1851(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_1852(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_1853(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1854(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1855(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1856(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1857(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b100110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1858(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b111) == 0b100)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1859(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1860(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1861(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b111) == 0b100)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1862(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1863(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_1864(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b11) == 0b01)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b01110000)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1865(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11011)&&(((insn >> 29) & 0b11) == 0b00)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1866(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11011)&&(((insn >> 29) & 0b11) == 0b00)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_1867(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0100)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1868(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1869(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1870(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1871(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1872(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1873(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1874(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1875(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1876(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1877(insn uint32) bool {
-	return (((insn >> 13) & 0b111111111) == 0b101101100)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1878(insn uint32) bool {
-	return (((insn >> 13) & 0b111111111) == 0b101100100)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_1879(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1880(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1881(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1882(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1883(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1884(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1885(insn uint32) bool {
-	return (((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b100)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1886(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b10)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1887(insn uint32) bool {
-	return (((insn >> 11) & 0b11111) == 0b11011)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b00000)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1888(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b100011111000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_1889(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b110011111000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1890(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111111) == 0b001010)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_1891(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111111111) == 0b110011111000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1892(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b100011111000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_1893(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b110011111000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1894(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111111) == 0b001010)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_1895(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111111111) == 0b110011111000)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1896(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1897(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1898(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1899(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1900(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1901(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1902(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1903(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1904(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1905(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1906(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1907(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1908(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1909(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1910(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b11)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1911(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b11)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1912(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1913(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1914(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1915(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1916(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1917(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1918(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1919(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1920(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1921(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b11)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1922(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b11)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1923(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1924(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1925(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1926(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1927(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1928(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1929(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1930(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1931(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1932(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1933(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1934(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1935(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1936(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b11111) == 0b00000)&&(((insn >> 10) & 0b111111) == 0b101001)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1937(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b11111) == 0b00000)&&(((insn >> 10) & 0b111111) == 0b101011)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1938(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b11111) == 0b00000)&&(((insn >> 10) & 0b1111111) == 0b0101101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1939(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b11111) == 0b00000)&&(((insn >> 10) & 0b11111111) == 0b00101111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_1940(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b01110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1941(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1942(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1943(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1944(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1011)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1945(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1011)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1946(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1111) == 0b1101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1947(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1111) == 0b1101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1948(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1949(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1950(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1951(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_1952(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1953(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1954(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1955(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1956(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1957(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1958(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1959(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1960(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1961(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1962(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1963(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1964(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_1965(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1966(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1967(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1968(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1969(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00111)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1970(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1971(insn uint32) bool {
-	return (((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1972(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1973(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1974(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1975(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1976(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1977(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1978(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b01110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1979(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1980(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1981(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1982(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1983(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1984(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1985(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1986(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b01110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1987(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1988(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1989(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1990(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 24) & 0b11111) == 0b11111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1991(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1992(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1993(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_1994(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b01110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_1995(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1996(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1997(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_1998(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_1999(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2000(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2001(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2002(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b110101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b11000001111)
-}
-
-func parse_2003(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2004(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2005(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2006(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b010001011)
-}
-
-func parse_2007(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2008(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2009(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2010(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b110101)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b11000001111)
-}
-
-func parse_2011(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2012(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2013(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2014(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b010001011)
-}
-
-func parse_2015(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2016(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2017(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2018(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2019(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2020(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2021(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2022(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2023(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2024(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2025(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2026(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2027(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2028(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2029(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2030(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2031(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2032(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2033(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2034(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2035(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2036(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2037(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2038(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2039(insn uint32) bool {
-	return (((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b100)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2040(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b11)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2041(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2042(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2043(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2044(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111111) == 0b000010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2045(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111111) == 0b000010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2046(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10010)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2047(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10010)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2048(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111111) == 0b000010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2049(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111111) == 0b000010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2050(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2051(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2052(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b01000)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2053(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b01000)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2054(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2055(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2056(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2057(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2058(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2059(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2060(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2061(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2062(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2063(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2064(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2065(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2066(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2067(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2068(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2069(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2070(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2071(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10100)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2072(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2073(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2074(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2075(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2076(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2077(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2078(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2079(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2080(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2081(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2082(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2083(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2084(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2085(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2086(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2087(insn uint32) bool {
-	return (((insn >> 12) & 0b0010) == 0b0010)&&(((insn >> 16) & 0b111111) == 0b000000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2088(insn uint32) bool {
-	return (((insn >> 12) & 0b0010) == 0b0010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011001)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2089(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2090(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2091(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2092(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2093(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2094(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2095(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2096(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2097(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2098(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2099(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2100(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2101(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2102(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 23) & 0b11) == 0b00)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2103(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2104(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2105(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2106(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11100000)
-}
-
-func parse_2107(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2108(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2109(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2110(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2111(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2112(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2113(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2114(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2115(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2116(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2117(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2118(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b11) == 0b11)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2119(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b11) == 0b11)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2120(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2121(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2122(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2123(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2124(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11100000)
-}
-
-func parse_2125(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2126(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2127(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2128(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2129(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2130(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2131(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2132(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2133(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2134(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2135(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2136(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 23) & 0b11) == 0b01)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2137(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2138(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2139(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2140(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2141(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2142(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2143(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11100000)
-}
-
-func parse_2144(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b11111111111) == 0b11100100001)
-}
-
-func parse_2145(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b11100001111)
-}
-
-func parse_2146(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2147(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2148(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2149(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2150(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2151(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2152(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2153(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2154(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2155(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2156(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2157(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2158(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b11) == 0b10)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2159(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b11) == 0b10)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2160(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2161(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2162(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2163(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2164(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2165(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2166(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11100000)
-}
-
-func parse_2167(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 16) & 0b111111) == 0b000000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2168(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b1000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011001)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2169(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b000)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2170(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2171(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2172(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2173(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2174(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2175(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2176(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2177(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2178(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2179(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2180(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11100100)
-}
-
-func parse_2181(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11100100)
-}
-
-func parse_2182(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2183(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2184(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 16) & 0b111111) == 0b000000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2185(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011001)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2186(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2187(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b001)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2188(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2189(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2190(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2191(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2192(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2193(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2194(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11100100)
-}
-
-func parse_2195(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11100100)
-}
-
-func parse_2196(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2197(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2198(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 16) & 0b111111) == 0b000000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011000)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2199(insn uint32) bool {
-	return (((insn >> 12) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011001)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2200(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b001)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2201(insn uint32) bool {
-	return (((insn >> 13) & 0b001) == 0b001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011011)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2202(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2203(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2204(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2205(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2206(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2207(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2208(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11100100)
-}
-
-func parse_2209(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11100100)
-}
-
-func parse_2210(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2211(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b11)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2212(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2213(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2214(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2215(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2216(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2217(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2218(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2219(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b001)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2220(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b011)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2221(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b010)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2222(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1110) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b010)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2223(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1111) == 0b0000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1111111) == 0b0011010)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2224(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2225(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2226(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2227(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2228(insn uint32) bool {
-	return (((insn >> 10) & 0b111111111111) == 0b000000000010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b011)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b011)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2229(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2230(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2231(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b01) == 0b00)&&(((insn >> 24) & 0b111111) == 0b011101)
-}
-
-func parse_2232(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2233(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2234(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2235(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_2236(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2237(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2238(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2239(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b000)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b101)
-}
-
-func parse_2240(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b000)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b01) == 0b00)
-}
-
-func parse_2241(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2242(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2243(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2244(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2245(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2246(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2247(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2248(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2249(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2250(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2251(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b111) == 0b001)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2252(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2253(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2254(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2255(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2256(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2257(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2258(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2259(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2260(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2261(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2262(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b111) == 0b001)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2263(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2264(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2265(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2266(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2267(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2268(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2269(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2270(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2271(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2272(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2273(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2274(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b111) == 0b001)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2275(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2276(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2277(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000000110)
-}
-
-func parse_2278(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2279(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100000001)
-}
-
-func parse_2280(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2281(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b101000010110)
-}
-
-func parse_2282(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2283(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11111111111) == 0b10100001001)
-}
-
-func parse_2284(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2285(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2286(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b111)&&(((insn >> 20) & 0b111) == 0b001)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2287(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b11) == 0b00)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b1111111) == 0b1110010)
-}
-
-func parse_2288(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b001)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b101)
-}
-
-func parse_2289(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b011)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b101)
-}
-
-func parse_2290(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b010)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b101)
-}
-
-func parse_2291(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b001)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b01) == 0b00)
-}
-
-func parse_2292(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b011)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b01) == 0b00)
-}
-
-func parse_2293(insn uint32) bool {
-	return (((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111) == 0b010)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b101)&&(((insn >> 30) & 0b01) == 0b00)
-}
-
-func parse_2294(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b01) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b111)
-}
-
-func parse_2295(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b01) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b111)
-}
-
-func parse_2296(insn uint32) bool {
-	return (((insn >> 22) & 0b01) == 0b00)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b111)
-}
-
-func parse_2297(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2298(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2299(insn uint32) bool {
-	return (((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2300(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 22) & 0b1111111111) == 0b1110010110)
-}
-
-func parse_2301(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b01) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b111)
-}
-
-func parse_2302(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2303(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b010)&&(((insn >> 22) & 0b1111111111) == 0b1110010110)
-}
-
-func parse_2304(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b111111) == 0b000000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1111111111) == 0b1110000100)
-}
-
-func parse_2305(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b100000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b111) == 0b111)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b11111111) == 0b10000100)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2306(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2307(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2308(insn uint32) bool {
-	return (((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2309(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2310(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2311(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b11)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2312(insn uint32) bool {
-	return (((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b01)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2313(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2314(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2315(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2316(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2317(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b01) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b1)&&(((insn >> 27) & 0b111) == 0b111)
-}
-
-func parse_2318(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2319(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2320(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2321(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_2322(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2323(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2324(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001000)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2325(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2326(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2327(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2328(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2329(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2330(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2331(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11011001)
-}
-
-func parse_2332(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b01011)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_2333(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100010)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_2334(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01011)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_2335(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2336(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2337(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2338(insn uint32) bool {
-	return (((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b100)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2339(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2340(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b11) == 0b01)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1000000)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_2341(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b111) == 0b001)&&(((insn >> 10) & 0b111) == 0b111)&&(((insn >> 15) & 0b1111111) == 0b1000010)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_2342(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2343(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2344(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b11) == 0b01)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_2345(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b111) == 0b001)&&(((insn >> 10) & 0b111) == 0b110)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_2346(insn uint32) bool {
-	return (((insn >> 14) & 0b11) == 0b00)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111) == 0b100011)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_2347(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2348(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2349(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2350(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_2351(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_2352(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2353(insn uint32) bool {
-	return (((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b100)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2354(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111) == 0b01011)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_2355(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100010)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_2356(insn uint32) bool {
-	return (((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01011)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b1)
-}
-
-func parse_2357(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2358(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2359(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2360(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2361(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b1111111111) == 0b0000010010)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2362(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b1111111111) == 0b0000010011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2363(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b1)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010000)
-}
-
-func parse_2364(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_2365(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_2366(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b1)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2367(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b1)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2368(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2369(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2370(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2371(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2372(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b100101111000)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2373(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b110101111000)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2374(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001110)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1100)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2375(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001110)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1100)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2376(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00011)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2377(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00011)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2378(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2379(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2380(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b01)&&(((insn >> 2) & 0b111) == 0b000)&&(((insn >> 21) & 0b111) == 0b000)&&(((insn >> 24) & 0b11111111) == 0b11010100)
-}
-
-func parse_2381(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2382(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2383(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_2384(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b10) == 0b10)
-}
-
-func parse_2385(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2386(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11) == 0b00)&&(((insn >> 26) & 0b1) == 0b0)&&(((insn >> 27) & 0b111) == 0b111)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2387(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b111111) == 0b011001)&&(((insn >> 30) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2388(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2389(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2390(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2391(insn uint32) bool {
-	return (((insn >> 19) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_2392(insn uint32) bool {
-	return (((insn >> 19) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_2393(insn uint32) bool {
-	return (((insn >> 19) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010101)
-}
-
-func parse_2394(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b111111) == 0b001110)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2395(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2396(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2397(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b11)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2398(insn uint32) bool {
-	return (((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b111111) == 0b011011)
-}
-
-func parse_2399(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b00)&&(((insn >> 24) & 0b111111) == 0b001110)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2400(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2401(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2402(insn uint32) bool {
-	return (((insn >> 24) & 0b1) == 0b0)&&(((insn >> 25) & 0b111111) == 0b011011)
-}
-
-func parse_2403(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 2) & 0b111) == 0b000)&&(((insn >> 21) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b11010100)
-}
-
-func parse_2404(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b011)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_2405(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001110)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2406(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2407(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2408(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b10)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2409(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11111111111) == 0b00000101101)
-}
-
-func parse_2410(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b10)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2411(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11111111111) == 0b00000101101)
-}
-
-func parse_2412(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001110)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2413(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b010)&&(((insn >> 8) & 0b1111) == 0b0010)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_2414(insn uint32) bool {
-	return (((insn >> 5) & 0b111) == 0b011)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_2415(insn uint32) bool {
-	return (((insn >> 5) & 0b111) == 0b011)&&(((insn >> 8) & 0b1111) == 0b0001)&&(((insn >> 12) & 0b1111) == 0b0011)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_2416(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2417(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2418(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2419(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2420(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2421(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0111)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2422(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b001)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2423(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2424(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2425(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2426(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2427(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b00010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2428(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2429(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2430(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2431(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2432(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00011)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2433(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0000)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2434(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2435(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2436(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2437(insn uint32) bool {
-	return (((insn >> 23) & 0b111111) == 0b100110)&&(((insn >> 29) & 0b11) == 0b10)
-}
-
-func parse_2438(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111) == 0b110001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2439(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b110011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2440(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2441(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2442(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11100)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2443(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2444(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2445(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b111111) == 0b111100)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2446(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11101)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2447(insn uint32) bool {
-	return (((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_2448(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b000000)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_2449(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000100100010111000)
-}
-
-func parse_2450(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000100110010111000)
-}
-
-func parse_2451(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_2452(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_2453(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_2454(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_2455(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_2456(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_2457(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01100101)
-}
-
-func parse_2458(insn uint32) bool {
-	return (((insn >> 16) & 0b1111111111111111) == 0b0000000000000000)
-}
-
-func parse_2459(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_2460(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0101)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2461(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0101)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2462(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2463(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b0010)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2464(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11001)&&(((insn >> 21) & 0b11111111111) == 0b01000100000)
-}
-
-func parse_2465(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11001)&&(((insn >> 21) & 0b11111111111) == 0b01000100100)
-}
-
-func parse_2466(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2467(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2468(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2469(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2470(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2471(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b1111111111) == 0b0000010110)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2472(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b1111111111) == 0b0000010111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2473(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b111111111) == 0b000001111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2474(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b111111111) == 0b000001111)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2475(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2476(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_2477(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2478(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_2479(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b1111111) == 0b0000010)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2480(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 23) & 0b1111111) == 0b0000010)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2481(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2482(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b1111111) == 0b0000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2483(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2484(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2485(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2486(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2487(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2488(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11011)&&(((insn >> 29) & 0b11) == 0b00)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_2489(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2490(insn uint32) bool {
-	return (((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b000)&&(((insn >> 22) & 0b1111111) == 0b1000111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_2491(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2492(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2493(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2494(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2495(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b011001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_2496(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b001)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2497(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2498(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2499(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2500(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2501(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2502(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2503(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2504(insn uint32) bool {
-	return (((insn >> 18) & 0b1111) == 0b0011)&&(((insn >> 22) & 0b1111111) == 0b1000111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_2505(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2506(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2507(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2508(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1111) == 0b0000)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2509(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b011011)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b0)
-}
-
-func parse_2510(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b001)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2511(insn uint32) bool {
-	return (((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b101)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2512(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2513(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2514(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0011)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2515(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11111) == 0b11000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2516(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2517(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2518(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2519(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011100)
-}
-
-func parse_2520(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_2521(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_2522(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010110)
-}
-
-func parse_2523(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010110)
-}
-
-func parse_2524(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010111)
-}
-
-func parse_2525(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b11111111111) == 0b11000001111)
-}
-
-func parse_2526(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b11111111111) == 0b11000001111)
-}
-
-func parse_2527(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2528(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2529(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2530(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010000)
-}
-
-func parse_2531(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011000)
-}
-
-func parse_2532(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_2533(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_2534(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_2535(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_2536(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2537(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2538(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2539(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_2540(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_2541(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2542(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2543(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2544(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b11) == 0b10)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2545(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2546(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011100)
-}
-
-func parse_2547(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_2548(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_2549(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b011)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010110)
-}
-
-func parse_2550(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010110)
-}
-
-func parse_2551(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010111)
-}
-
-func parse_2552(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b11111111111) == 0b11000001111)
-}
-
-func parse_2553(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b010)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b11111111111) == 0b11000001111)
-}
-
-func parse_2554(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2555(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2556(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2557(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010000)
-}
-
-func parse_2558(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011000)
-}
-
-func parse_2559(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_2560(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_2561(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_2562(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011001)
-}
-
-func parse_2563(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2564(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2565(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2566(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_2567(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_2568(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2569(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2570(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b10)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2571(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2572(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b100110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2573(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b111) == 0b100)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2574(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2575(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2576(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b111) == 0b100)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2577(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2578(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2579(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b11) == 0b01)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111) == 0b01110000)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2580(insn uint32) bool {
-	return (((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b11) == 0b01)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11011)&&(((insn >> 29) & 0b11) == 0b00)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_2581(insn uint32) bool {
-	return (((insn >> 10) & 0b11111) == 0b11111)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b11) == 0b10)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11011)&&(((insn >> 29) & 0b11) == 0b00)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_2582(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b0100)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2583(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2584(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2585(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2586(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2587(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2588(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2589(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2590(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2591(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2592(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2593(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2594(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2595(insn uint32) bool {
-	return (((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b100)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2596(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b10)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2597(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111111111) == 0b100011111000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2598(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b110011111000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2599(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111111) == 0b001010)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2600(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111111111) == 0b110011111000)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2601(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2602(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2603(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2604(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2605(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2606(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2607(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2608(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2609(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2610(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2611(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2612(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2613(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2614(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2615(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2616(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2617(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2618(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2619(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2620(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2621(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2622(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b1)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2623(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2624(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10001)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2625(insn uint32) bool {
-	return (((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b10000)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1111) == 0b1010)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2626(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2627(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2628(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2629(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2630(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2631(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2632(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2633(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111) == 0b110101)&&(((insn >> 20) & 0b1) == 0b0)&&(((insn >> 21) & 0b11111111111) == 0b11000001111)
-}
-
-func parse_2634(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b11011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2635(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2636(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2637(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 20) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b010001011)
-}
-
-func parse_2638(insn uint32) bool {
-	return (((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b11011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2639(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2640(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2641(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2642(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2643(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2644(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2645(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2646(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2647(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2648(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2649(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1001)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2650(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2651(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2652(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2653(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2654(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2655(insn uint32) bool {
-	return (((insn >> 14) & 0b11) == 0b11)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b11)&&(((insn >> 19) & 0b111) == 0b100)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2656(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b11)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2657(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2658(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2659(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2660(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111111) == 0b000010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2661(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111111) == 0b000010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2662(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2663(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2664(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b00010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2665(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2666(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2667(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2668(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111111) == 0b101000)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2669(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b111111) == 0b101010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2670(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b1111111) == 0b0101100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2671(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b11) == 0b00)&&(((insn >> 8) & 0b11) == 0b10)&&(((insn >> 10) & 0b11111111) == 0b00101110)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2672(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2673(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2674(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2675(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2676(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b1) == 0b1)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2677(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b11100)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2678(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b0)&&(((insn >> 19) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b00)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2679(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2680(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2681(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2682(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1111)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01111)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2683(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1111) == 0b0011)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2684(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b011110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2685(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11111) == 0b00011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2686(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2687(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2688(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b1111111111) == 0b0000010010)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2689(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b1111111111) == 0b0000010011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2690(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b111111111) == 0b000001101)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2691(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b111) == 0b000)&&(((insn >> 10) & 0b111) == 0b101)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b111111111) == 0b000001101)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2692(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2693(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2694(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11111) == 0b10100)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2695(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2696(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b1010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b010001010)
-}
-
-func parse_2697(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2698(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2699(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b1)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010000)
-}
-
-func parse_2700(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_2701(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010001)
-}
-
-func parse_2702(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b1)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b001)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2703(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b1)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2704(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b1)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b11) == 0b11)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000010)
-}
-
-func parse_2705(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b1)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_2706(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b1)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b111111111) == 0b110000011)
-}
-
-func parse_2707(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b111) == 0b010)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2708(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b100110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2709(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2710(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2711(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b10)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2712(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b11) == 0b11)&&(((insn >> 24) & 0b1) == 0b1)&&(((insn >> 25) & 0b11111) == 0b10000)&&(((insn >> 30) & 0b11) == 0b10)
-}
-
-func parse_2713(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00011)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b11110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2714(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b00011)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2715(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b100)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b0)&&(((insn >> 18) & 0b1) == 0b1)&&(((insn >> 19) & 0b111) == 0b011)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2716(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b111110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 30) & 0b11) == 0b01)
-}
-
-func parse_2717(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 19) & 0b1111) == 0b0000)&&(((insn >> 23) & 0b111111) == 0b011110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2718(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b1110)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2719(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2720(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2721(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2722(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b00)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b1) == 0b1)&&(((insn >> 14) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2723(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2724(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000101)
-}
-
-func parse_2725(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2726(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111111111) == 0b100101111000)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2727(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111111111) == 0b110101111000)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2728(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001110)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b1100)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2729(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001110)&&(((insn >> 16) & 0b1) == 0b0)&&(((insn >> 17) & 0b1) == 0b1)&&(((insn >> 18) & 0b1111) == 0b1100)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2730(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2731(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b1) == 0b1)&&(((insn >> 6) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000010101)
-}
-
-func parse_2732(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 20) & 0b111111111111) == 0b110000011101)
-}
-
-func parse_2733(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b00)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2734(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b01)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2735(insn uint32) bool {
-	return (((insn >> 13) & 0b111) == 0b101)&&(((insn >> 16) & 0b1) == 0b1)&&(((insn >> 17) & 0b11) == 0b10)&&(((insn >> 19) & 0b111) == 0b010)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2736(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b01)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001110)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2737(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2738(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2739(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b01)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2740(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11111111111) == 0b00000101101)
-}
-
-func parse_2741(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b01)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2742(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11111111111) == 0b00000101101)
-}
-
-func parse_2743(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b01)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001110)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2744(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111111111111) == 0b110110111000)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2745(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b1)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000100110111111000)
-}
-
-func parse_2746(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111) == 0b110100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2747(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111) == 0b110101)&&(((insn >> 21) & 0b11111111111) == 0b11000001001)
-}
-
-func parse_2748(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b01)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2749(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b01)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2750(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b010)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_2751(insn uint32) bool {
-	return (((insn >> 5) & 0b111) == 0b000)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b11111111111111111111) == 0b11010101000000110001)
-}
-
-func parse_2752(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b011)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_2753(insn uint32) bool {
-	return (((insn >> 5) & 0b111) == 0b001)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b11111111111111111111) == 0b11010101000000110001)
-}
-
-func parse_2754(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2755(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2756(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2757(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2758(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2759(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2760(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2761(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2762(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2763(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2764(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2765(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2766(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2767(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2768(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2769(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2770(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2771(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2772(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2773(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2774(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b1)&&(((insn >> 12) & 0b1111) == 0b0101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2775(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2776(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 14) & 0b11) == 0b01)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2777(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1111) == 0b0101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2778(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b1)&&(((insn >> 10) & 0b111111) == 0b001100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2779(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b001100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00100101)
-}
-
-func parse_2780(insn uint32) bool {
-	return (((insn >> 0) & 0b11) == 0b00)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1111111111) == 0b1000100100)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 22) & 0b1) == 0b0)&&(((insn >> 23) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b100101)&&(((insn >> 30) & 0b11) == 0b00)
-}
-
-func parse_2781(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b001)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0100)&&(((insn >> 16) & 0b111) == 0b000)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_2782(insn uint32) bool {
-	return (((insn >> 21) & 0b11111111111) == 0b11001110100)
-}
-
-func parse_2783(insn uint32) bool {
-	return (((insn >> 10) & 0b111111) == 0b001101)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000100)
-}
-
-func parse_2784(insn uint32) bool {
-	return (((insn >> 5) & 0b11111) == 0b11111)&&(((insn >> 11) & 0b111) == 0b000)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11111) == 0b00001)&&(((insn >> 21) & 0b11111111) == 0b11010110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 30) & 0b1) == 0b1)&&(((insn >> 31) & 0b1) == 0b1)
-}
-
-func parse_2785(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b111)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_2786(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11111) == 0b10010)&&(((insn >> 17) & 0b11111) == 0b10000)&&(((insn >> 24) & 0b11111) == 0b01110)&&(((insn >> 29) & 0b1) == 0b0)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2787(insn uint32) bool {
-	return (((insn >> 0) & 0b11111) == 0b11111)&&(((insn >> 5) & 0b111) == 0b001)&&(((insn >> 8) & 0b1111) == 0b0000)&&(((insn >> 12) & 0b1111) == 0b0010)&&(((insn >> 16) & 0b111) == 0b011)&&(((insn >> 19) & 0b11) == 0b00)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 22) & 0b1111111111) == 0b1101010100)
-}
-
-func parse_2788(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11) == 0b00)&&(((insn >> 18) & 0b111111111111) == 0b000000000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2789(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11) == 0b10)&&(((insn >> 18) & 0b111111111111) == 0b000000000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2790(insn uint32) bool {
-	return (((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11) == 0b00)&&(((insn >> 18) & 0b111111111111) == 0b000000000011)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2791(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11) == 0b01)&&(((insn >> 18) & 0b11) == 0b11)&&(((insn >> 20) & 0b1111111111) == 0b0000000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2792(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11) == 0b01)&&(((insn >> 18) & 0b11) == 0b11)&&(((insn >> 20) & 0b1111111111) == 0b0000000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2793(insn uint32) bool {
-	return (((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11) == 0b10)&&(((insn >> 18) & 0b11) == 0b11)&&(((insn >> 20) & 0b1111111111) == 0b0000000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2794(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 16) & 0b11) == 0b11)&&(((insn >> 18) & 0b11) == 0b11)&&(((insn >> 20) & 0b1111111111) == 0b0000000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2795(insn uint32) bool {
-	return (((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b111) == 0b000)&&(((insn >> 15) & 0b1) == 0b1)&&(((insn >> 16) & 0b11) == 0b11)&&(((insn >> 18) & 0b11) == 0b11)&&(((insn >> 20) & 0b1111111111) == 0b0000000000)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2796(insn uint32) bool {
-	return (((insn >> 8) & 0b11) == 0b00)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000000001000000000)
-}
-
-func parse_2797(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b1)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 2) & 0b1) == 0b0)&&(((insn >> 3) & 0b1) == 0b0)&&(((insn >> 4) & 0b111111) == 0b000000)&&(((insn >> 10) & 0b1111111111) == 0b1000000000)&&(((insn >> 20) & 0b1111111111) == 0b0000000100)&&(((insn >> 30) & 0b11) == 0b11)
-}
-
-func parse_2798(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b11)&&(((insn >> 14) & 0b1) == 0b0)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001110)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2799(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2800(insn uint32) bool {
-	return (((insn >> 4) & 0b1) == 0b0)&&(((insn >> 9) & 0b1) == 0b0)&&(((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b010)&&(((insn >> 20) & 0b11) == 0b10)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2801(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2802(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11111111111) == 0b00000101101)
-}
-
-func parse_2803(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b111) == 0b011)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b00000101)
-}
-
-func parse_2804(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b1) == 0b0)&&(((insn >> 12) & 0b1) == 0b0)&&(((insn >> 13) & 0b111) == 0b000)&&(((insn >> 21) & 0b11111111111) == 0b00000101101)
-}
-
-func parse_2805(insn uint32) bool {
-	return (((insn >> 10) & 0b11) == 0b10)&&(((insn >> 12) & 0b11) == 0b11)&&(((insn >> 14) & 0b1) == 0b1)&&(((insn >> 15) & 0b1) == 0b0)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b111111) == 0b001110)&&(((insn >> 31) & 0b1) == 0b0)
-}
-
-func parse_2806(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b111111111111) == 0b110110111000)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2807(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 1) & 0b1) == 0b0)&&(((insn >> 5) & 0b11) == 0b00)&&(((insn >> 10) & 0b1111111111111111111111) == 0b1100000100110111111000)
-}
-
-func parse_2808(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b110100)&&(((insn >> 21) & 0b1) == 0b1)&&(((insn >> 24) & 0b11111111) == 0b11000001)
-}
-
-func parse_2809(insn uint32) bool {
-	return (((insn >> 0) & 0b1) == 0b0)&&(((insn >> 10) & 0b111111) == 0b110101)&&(((insn >> 21) & 0b11111111111) == 0b11000001001)
-}
-
-func parse_2810(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b0)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
-func parse_2811(insn uint32) bool {
-	return (((insn >> 10) & 0b1) == 0b1)&&(((insn >> 11) & 0b11) == 0b00)&&(((insn >> 13) & 0b111) == 0b111)&&(((insn >> 21) & 0b1) == 0b0)&&(((insn >> 24) & 0b11111111) == 0b01000100)
-}
-
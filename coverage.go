@@ -0,0 +1,222 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// A WordSpan is the half-open range of words [Lo, Hi).
+type WordSpan struct {
+	Lo, Hi uint64
+}
+
+func (s WordSpan) Size() uint64 {
+	return s.Hi - s.Lo
+}
+
+func (s WordSpan) String() string {
+	return fmt.Sprintf("%08x-%08x", s.Lo, s.Hi-1)
+}
+
+// freeSpanBits is the number of prefix bits FreeSpans splits on: free
+// ranges are found to a granularity of 2^(32-freeSpanBits) words.
+const freeSpanBits = 24
+
+// FreeSpans returns the contiguous ranges of words in the free set, largest
+// first. It splits the word space on prefixes, so ranges smaller than
+// 2^(32-freeSpanBits) words are not found.
+func FreeSpans(free CubeSet) []WordSpan {
+	var spans []WordSpan
+	var walk func(cs []Cube, depth int, node Cube)
+	walk = func(cs []Cube, depth int, node Cube) {
+		live, cov := restrict(cs, node)
+		switch {
+		case cov == coverFull:
+			lo := uint64(node.Value)
+			hi := lo + node.Size()
+			if n := len(spans); n > 0 && spans[n-1].Hi == lo {
+				spans[n-1].Hi = hi
+			} else {
+				spans = append(spans, WordSpan{lo, hi})
+			}
+			return
+		case cov == coverNone || depth == freeSpanBits:
+			return
+		}
+		bit := uint32(1) << uint(31-depth)
+		walk(live, depth+1, Cube{node.Mask | bit, node.Value})
+		walk(live, depth+1, Cube{node.Mask | bit, node.Value | bit})
+	}
+	walk(free, 0, Universe)
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].Size() > spans[j].Size()
+	})
+	return spans
+}
+
+// recordPatterns parses the diagram of every record.
+func recordPatterns(records []Record) ([]Pattern, error) {
+	ps := make([]Pattern, len(records))
+	for i, r := range records {
+		p, err := ParseDiagram(r.RegDiagram)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.ID, err)
+		}
+		ps[i] = p
+	}
+	return ps, nil
+}
+
+// A SpaceShare is the number of words matched by a group of records.
+type SpaceShare struct {
+	Name  string
+	Words uint64
+}
+
+// Coverage describes how much of the word space the records use.
+type Coverage struct {
+	Allocated  uint64
+	Classes    []SpaceShare
+	Features   []SpaceShare
+	Versions   []SpaceShare // words first allocated by each version
+	Unassigned []WordSpan
+}
+
+// groupShares returns the number of words matched by the records of each
+// group, largest first. A record may be in several groups.
+func groupShares(records []Record, ps []Pattern, groups func(r *Record) []string) []SpaceShare {
+	members := make(map[string][]Pattern)
+	for i := range records {
+		for _, g := range groups(&records[i]) {
+			members[g] = append(members[g], ps[i])
+		}
+	}
+	var shares []SpaceShare
+	for g, gps := range members {
+		shares = append(shares, SpaceShare{g, PatternSet(gps).Count()})
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].Words != shares[j].Words {
+			return shares[i].Words > shares[j].Words
+		}
+		return shares[i].Name < shares[j].Name
+	})
+	return shares
+}
+
+func NewCoverage(records []Record) (*Coverage, error) {
+	ps, err := recordPatterns(records)
+	if err != nil {
+		return nil, err
+	}
+	all := PatternSet(ps)
+	c := &Coverage{Allocated: all.Count()}
+	c.Classes = groupShares(records, ps, func(r *Record) []string {
+		return []string{r.InstrClass}
+	})
+	c.Features = groupShares(records, ps, func(r *Record) []string {
+		if r.Features == "" {
+			return []string{"base"}
+		}
+		return strings.Split(r.Features, ";")
+	})
+
+	var versions []Version
+	seen := make(map[Version]bool)
+	for _, r := range records {
+		if v, ok := ParseVersion(r.MinVersion); ok && !seen[v] {
+			seen[v] = true
+			versions = append(versions, v)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[j].after(versions[i])
+	})
+	var before CubeSet
+	for _, v := range versions {
+		var at []Pattern
+		for i, r := range records {
+			if rv, ok := ParseVersion(r.MinVersion); ok && rv == v {
+				at = append(at, ps[i])
+			}
+		}
+		set := PatternSet(at)
+		c.Versions = append(c.Versions, SpaceShare{v.String(), set.Difference(before).Count()})
+		before = before.Union(set)
+	}
+
+	c.Unassigned = FreeSpans(all.Complement())
+	return c, nil
+}
+
+func percent(n uint64) string {
+	return fmt.Sprintf("%.4f%%", float64(n)/(1<<32)*100)
+}
+
+func (c *Coverage) WriteText(w io.Writer, top int) {
+	fmt.Fprintf(w, "allocated: %d of %d words (%s)\n", c.Allocated, uint64(1)<<32, percent(c.Allocated))
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	section := func(title string, shares []SpaceShare) {
+		fmt.Fprintf(w, "\n%s:\n", title)
+		for _, s := range shares {
+			fmt.Fprintf(tw, "  %s\t%d\t%s\t\n", s.Name, s.Words, percent(s.Words))
+		}
+		tw.Flush()
+	}
+	section("by class", c.Classes)
+	section("by feature", c.Features)
+	section("first allocated by version", c.Versions)
+
+	fmt.Fprintf(w, "\nlargest unallocated ranges:\n")
+	for i, s := range c.Unassigned {
+		if i == top {
+			break
+		}
+		fmt.Fprintf(tw, "  %s\t%d\t%s\t\n", s, s.Size(), percent(s.Size()))
+	}
+	tw.Flush()
+}
+
+// An Overlap is a pair of records whose diagrams match some of the same
+// words.
+type Overlap struct {
+	A, B  int
+	Words uint64
+}
+
+// Overlaps returns every pair of records that match a common word, in record
+// order.
+func Overlaps(records []Record) ([]Overlap, error) {
+	ps, err := recordPatterns(records)
+	if err != nil {
+		return nil, err
+	}
+	sets := make([]CubeSet, len(ps))
+	for i := range ps {
+		sets[i] = PatternSet(ps[i : i+1])
+	}
+	var out []Overlap
+	for i := range ps {
+		a := Cube{ps[i].Mask, ps[i].Value}
+		for j := i + 1; j < len(ps); j++ {
+			if !a.Intersects(Cube{ps[j].Mask, ps[j].Value}) {
+				continue
+			}
+			if n := sets[i].Intersect(sets[j]).Count(); n != 0 {
+				out = append(out, Overlap{i, j, n})
+			}
+		}
+	}
+	return out, nil
+}
+
+func writeOverlaps(w io.Writer, records []Record, overlaps []Overlap) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, o := range overlaps {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t\n", records[o.A].ID, records[o.B].ID, o.Words)
+	}
+	tw.Flush()
+}
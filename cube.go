@@ -0,0 +1,213 @@
+package main
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// A Cube is the set of words whose bits under Mask equal Value. Its string
+// form has an x for each bit outside the mask.
+type Cube struct {
+	Mask  uint32
+	Value uint32
+}
+
+// Universe is the cube of every word.
+var Universe = Cube{}
+
+func (c Cube) Size() uint64 {
+	return 1 << uint(32-bits.OnesCount32(c.Mask))
+}
+
+func (c Cube) String() string {
+	return Pattern{Mask: c.Mask, Value: c.Value}.String()
+}
+
+func (c Cube) Intersects(o Cube) bool {
+	return (c.Value^o.Value)&c.Mask&o.Mask == 0
+}
+
+// Covers reports whether every word of o is in c.
+func (c Cube) Covers(o Cube) bool {
+	return c.Mask&^o.Mask == 0 && o.Value&c.Mask == c.Value
+}
+
+func (c Cube) Intersect(o Cube) (Cube, bool) {
+	if !c.Intersects(o) {
+		return Cube{}, false
+	}
+	return Cube{c.Mask | o.Mask, c.Value | o.Value}, true
+}
+
+// A CubeSet is a set of words stored as disjoint cubes. The set operations
+// work by splitting the word space on the bits the operands fix until, in
+// each part, the result no longer depends on how it is split further, so
+// they are exact and never enumerate words.
+type CubeSet []Cube
+
+// cover describes how a list of cubes meets a part of the word space.
+type cover int
+
+const (
+	coverNone cover = iota
+	coverPartial
+	coverFull
+)
+
+// restrict returns the cubes of cs that meet node and how they cover it. If
+// one cube covers node, it is the only one returned.
+func restrict(cs []Cube, node Cube) ([]Cube, cover) {
+	var live []Cube
+	for _, c := range cs {
+		if !c.Intersects(node) {
+			continue
+		}
+		if c.Covers(node) {
+			return []Cube{c}, coverFull
+		}
+		live = append(live, c)
+	}
+	if len(live) == 0 {
+		return nil, coverNone
+	}
+	return live, coverPartial
+}
+
+// outcomes returns the values op can take given how each operand covers a
+// part of the space.
+func outcomes(op func(a, b bool) bool, ca, cb cover) (canTrue, canFalse bool) {
+	states := func(c cover) []bool {
+		switch c {
+		case coverNone:
+			return []bool{false}
+		case coverFull:
+			return []bool{true}
+		}
+		return []bool{false, true}
+	}
+	for _, a := range states(ca) {
+		for _, b := range states(cb) {
+			if op(a, b) {
+				canTrue = true
+			} else {
+				canFalse = true
+			}
+		}
+	}
+	return canTrue, canFalse
+}
+
+// splitCubes picks the bit outside mask that is fixed by the most cubes.
+func splitCubes(mask uint32, lists ...[]Cube) uint32 {
+	var counts [32]int
+	for _, cs := range lists {
+		for _, c := range cs {
+			for m := c.Mask &^ mask; m != 0; m &= m - 1 {
+				counts[bits.TrailingZeros32(m)]++
+			}
+		}
+	}
+	best := 0
+	for i := range counts {
+		if counts[i] > counts[best] {
+			best = i
+		}
+	}
+	return 1 << uint(best)
+}
+
+// combine returns the words of node for which op holds, where a and b are
+// the (possibly overlapping) cubes of the two operands.
+func combine(op func(a, b bool) bool, a, b []Cube, node Cube) CubeSet {
+	var out CubeSet
+	var walk func(a, b []Cube, node Cube)
+	walk = func(a, b []Cube, node Cube) {
+		la, ca := restrict(a, node)
+		lb, cb := restrict(b, node)
+		canTrue, canFalse := outcomes(op, ca, cb)
+		if !canFalse {
+			out = append(out, node)
+			return
+		}
+		if !canTrue {
+			return
+		}
+		bit := splitCubes(node.Mask, la, lb)
+		walk(la, lb, Cube{node.Mask | bit, node.Value})
+		walk(la, lb, Cube{node.Mask | bit, node.Value | bit})
+	}
+	walk(a, b, node)
+	return out
+}
+
+func or(a, b bool) bool     { return a || b }
+func and(a, b bool) bool    { return a && b }
+func andNot(a, b bool) bool { return a && !b }
+
+// NewCubeSet returns the set of words in any of the cubes, which may
+// overlap.
+func NewCubeSet(cs ...Cube) CubeSet {
+	return combine(or, cs, nil, Universe)
+}
+
+// PatternSet returns the set of words matched by any of the patterns.
+func PatternSet(ps []Pattern) CubeSet {
+	var cs []Cube
+	for _, p := range ps {
+		var excl []Cube
+		for _, e := range p.Excludes {
+			excl = append(excl, Cube{e.Mask, e.Value})
+		}
+		cs = append(cs, combine(andNot, []Cube{{p.Mask, p.Value}}, excl, Universe)...)
+	}
+	return NewCubeSet(cs...)
+}
+
+func (s CubeSet) Count() uint64 {
+	n := uint64(0)
+	for _, c := range s {
+		n += c.Size()
+	}
+	return n
+}
+
+func (s CubeSet) Union(o CubeSet) CubeSet {
+	return combine(or, s, o, Universe)
+}
+
+func (s CubeSet) Intersect(o CubeSet) CubeSet {
+	return combine(and, s, o, Universe)
+}
+
+func (s CubeSet) Difference(o CubeSet) CubeSet {
+	return combine(andNot, s, o, Universe)
+}
+
+func (s CubeSet) Complement() CubeSet {
+	return combine(andNot, []Cube{Universe}, s, Universe)
+}
+
+// Within returns the part of the set inside the cube c.
+func (s CubeSet) Within(c Cube) CubeSet {
+	var out CubeSet
+	for _, x := range s {
+		if i, ok := x.Intersect(c); ok {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+// sortLargest sorts cubes largest first.
+func sortLargest(out []Cube) {
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Size() != b.Size() {
+			return a.Size() > b.Size()
+		}
+		if a.Mask != b.Mask {
+			return a.Mask < b.Mask
+		}
+		return a.Value < b.Value
+	})
+}
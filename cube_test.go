@@ -0,0 +1,77 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// The tests fix the top 16 bits of every pattern so that the sets can be
+// checked against the words they should contain.
+const testFixed = 0x12340000
+
+func randomPattern(r *rand.Rand) Pattern {
+	mask := uint32(r.Intn(1<<16)&r.Intn(1<<16)) | 0xffff0000
+	p := Pattern{Mask: mask, Value: (testFixed | uint32(r.Intn(1<<16))) & mask}
+	if r.Intn(2) == 0 {
+		em := uint32(r.Intn(1 << 16))
+		p.Excludes = []Exclude{{em, uint32(r.Intn(1<<16)) & em}}
+	}
+	return p
+}
+
+func matchesAny(ps []Pattern, w uint32) bool {
+	for _, p := range ps {
+		if p.Match(w) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCubeSet(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for it := 0; it < 200; it++ {
+		var a, b []Pattern
+		for i := r.Intn(6); i >= 0; i-- {
+			a = append(a, randomPattern(r))
+		}
+		for i := r.Intn(6); i >= 0; i-- {
+			b = append(b, randomPattern(r))
+		}
+		var na, union, inter, diff uint64
+		for lo := uint32(0); lo < 1<<16; lo++ {
+			w := testFixed | lo
+			x, y := matchesAny(a, w), matchesAny(b, w)
+			if x {
+				na++
+			}
+			if x || y {
+				union++
+			}
+			if x && y {
+				inter++
+			}
+			if x && !y {
+				diff++
+			}
+		}
+		sa, sb := PatternSet(a), PatternSet(b)
+		check := func(op string, s CubeSet, want uint64) {
+			if got := s.Count(); got != want {
+				t.Fatalf("%d: %s has %d words, want %d", it, op, got, want)
+			}
+			for i := range s {
+				for j := i + 1; j < len(s); j++ {
+					if s[i].Intersects(s[j]) {
+						t.Fatalf("%d: %s has overlapping cubes %s and %s", it, op, s[i], s[j])
+					}
+				}
+			}
+		}
+		check("a", sa, na)
+		check("a|b", sa.Union(sb), union)
+		check("a&b", sa.Intersect(sb), inter)
+		check("a-b", sa.Difference(sb), diff)
+		check("^a", sa.Complement(), 1<<32-na)
+	}
+}
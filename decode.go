@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var decodeCmd = &command{
+	name:  "decode",
+	args:  "[flags] SPECDIR [WORD...]",
+	short: "decode instruction words given as arguments or read from stdin",
+	run:   runDecode,
+}
+
+// parseWord parses a hexadecimal instruction word, with or without a 0x
+// prefix. Without the prefix exactly eight digits are required so that
+// other hex-looking tokens in a dump are not mistaken for words.
+func parseWord(s string) (uint32, bool) {
+	digits := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if digits == s && len(digits) != 8 {
+		return 0, false
+	}
+	w, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(w), true
+}
+
+// readHexWords reads instruction words from text. Lines containing a colon
+// are treated as objdump-style "ADDR: WORD ..." lines and contribute the
+// first word after the colon; other lines contribute every word on them.
+func readHexWords(r io.Reader) ([]uint32, error) {
+	var words []uint32
+	err := scanHexWords(r, func(w uint32) error {
+		words = append(words, w)
+		return nil
+	})
+	return words, err
+}
+
+// scanHexWords is like readHexWords but calls fn with each word as it is
+// read.
+func scanHexWords(r io.Reader, fn func(uint32) error) error {
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		_, rest, dump := strings.Cut(line, ":")
+		if !dump {
+			rest = line
+		}
+		for _, tok := range strings.Fields(rest) {
+			if w, ok := parseWord(tok); ok {
+				if err := fn(w); err != nil {
+					return err
+				}
+				if dump {
+					break
+				}
+			}
+		}
+	}
+	return sc.Err()
+}
+
+func runDecode(fs *flag.FlagSet, args []string) error {
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() < 1 {
+		return usagef("expected a spec directory")
+	}
+	spec, err := LoadSpec(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	var words []uint32
+	if fs.NArg() > 1 {
+		for _, a := range fs.Args()[1:] {
+			w, err := strconv.ParseUint(strings.TrimPrefix(a, "0x"), 16, 32)
+			if err != nil {
+				return usagef("invalid instruction word %q", a)
+			}
+			words = append(words, uint32(w))
+		}
+	} else {
+		words, err = readHexWords(os.Stdin)
+		if err != nil {
+			return err
+		}
+	}
+
+	dec := NewDecoder(NewMatchers(spec))
+	for _, w := range words {
+		writeDecoding(os.Stdout, dec.Decode(w))
+	}
+	return nil
+}
+
+func matcherName(m *Matcher) string {
+	return fmt.Sprintf("%s (%s, %s, %s)", m.Mnemonic(), m.File, m.IClass.Id, m.Encoding.Name)
+}
+
+func writeDecoding(w io.Writer, d *Decoding) {
+	best := d.Best()
+	if best == nil {
+		fmt.Fprintf(w, "%#08x: unallocated\n", d.Word)
+	} else {
+		fmt.Fprintf(w, "%#08x: %s\n", d.Word, matcherName(best))
+		fmt.Fprintf(w, "\t%s\n", best.Encoding.Asm())
+		var fields []string
+		for _, f := range best.IClass.Fields() {
+			fields = append(fields, fmt.Sprintf("%s=%0*b", f.Name, f.Width, f.Extract(d.Word)))
+		}
+		fmt.Fprintf(w, "\tfields: %s\n", strings.Join(fields, " "))
+		for _, m := range d.Matches[1:] {
+			fmt.Fprintf(w, "\talso matches: %s\n", matcherName(m))
+		}
+	}
+	for _, m := range d.Conflicts {
+		fmt.Fprintf(w, "\texcluded by constraint: %s %s\n", matcherName(m), m.Pattern)
+	}
+}
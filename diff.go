@@ -0,0 +1,255 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+type Change struct {
+	File     string
+	IClass   string `json:",omitempty"`
+	Encoding string `json:",omitempty"`
+	Old      string
+	New      string
+}
+
+type SpecDiff struct {
+	AddedInsns       []string
+	RemovedInsns     []string
+	AddedEncodings   []string
+	RemovedEncodings []string
+	ChangedDiagrams  []Change
+	ChangedPatterns  []Change
+	ChangedVariants  []Change
+	ChangedEffects   []Change
+	AddedFeatures    []string
+	RemovedFeatures  []string
+}
+
+type iclassKey struct {
+	file string
+	id   string
+}
+
+func iclasses(s *Spec) map[iclassKey]IClass {
+	m := make(map[iclassKey]IClass)
+	for _, f := range s.Files {
+		for _, c := range f.Insn.Classes.IClass {
+			m[iclassKey{f.Name, c.Id}] = c
+		}
+	}
+	return m
+}
+
+type encodingKey struct {
+	file, iclass, name string
+}
+
+func (k encodingKey) String() string {
+	return k.file + ": " + k.name
+}
+
+// encodings returns the bit pattern of every encoding, as its fixed bits
+// followed by the patterns its constraints rule out.
+func encodings(s *Spec) map[encodingKey]string {
+	m := make(map[encodingKey]string)
+	for _, f := range s.Files {
+		for _, c := range f.Insn.Classes.IClass {
+			for i := range c.Encodings {
+				e := &c.Encodings[i]
+				pat := c.Bits(e)
+				for _, ex := range c.EncodingPattern(e).Excludes {
+					pat += " != " + Pattern{Mask: ex.Mask, Value: ex.Value}.String()
+				}
+				m[encodingKey{f.Name, c.Id, e.Name}] = pat
+			}
+		}
+	}
+	return m
+}
+
+func encodingNames(m map[encodingKey]string) map[string]bool {
+	names := make(map[string]bool)
+	for k := range m {
+		names[k.String()] = true
+	}
+	return names
+}
+
+func features(s *Spec) map[string]bool {
+	m := make(map[string]bool)
+	for _, f := range s.Files {
+		for _, feat := range f.Insn.Features() {
+			m[feat] = true
+		}
+		for _, v := range f.Insn.Variants() {
+			m[v] = true
+		}
+	}
+	return m
+}
+
+// setDiff returns the sorted keys that are in a but not in b.
+func setDiff(a, b map[string]bool) []string {
+	var d []string
+	for k := range a {
+		if !b[k] {
+			d = append(d, k)
+		}
+	}
+	sort.Strings(d)
+	return d
+}
+
+func variantString(a ArchVariants) string {
+	var vs []string
+	for _, v := range a.Variants {
+		vs = append(vs, strings.Trim(v.Name+" "+v.Feature, " "))
+	}
+	return strings.Join(vs, ";")
+}
+
+func DiffSpecs(old, cur *Spec) *SpecDiff {
+	d := &SpecDiff{}
+
+	oldfiles := make(map[string]bool)
+	for _, f := range old.Files {
+		oldfiles[f.Name] = true
+	}
+	newfiles := make(map[string]bool)
+	for _, f := range cur.Files {
+		newfiles[f.Name] = true
+	}
+	d.AddedInsns = setDiff(newfiles, oldfiles)
+	d.RemovedInsns = setDiff(oldfiles, newfiles)
+
+	oldenc, newenc := encodings(old), encodings(cur)
+	d.AddedEncodings = setDiff(encodingNames(newenc), encodingNames(oldenc))
+	d.RemovedEncodings = setDiff(encodingNames(oldenc), encodingNames(newenc))
+	newnames := make(map[string]string)
+	for k, p := range newenc {
+		newnames[k.String()] = p
+	}
+	for k, o := range oldenc {
+		if n, ok := newnames[k.String()]; ok && n != o {
+			d.ChangedPatterns = append(d.ChangedPatterns, Change{k.file, k.iclass, k.name, o, n})
+		}
+	}
+
+	newclasses := iclasses(cur)
+	for k, oc := range iclasses(old) {
+		nc, ok := newclasses[k]
+		if !ok {
+			continue
+		}
+		if o, n := oc.RegDiagram.String(), nc.RegDiagram.String(); o != n {
+			d.ChangedDiagrams = append(d.ChangedDiagrams, Change{File: k.file, IClass: k.id, Old: o, New: n})
+		}
+		if o, n := variantString(oc.ArchVariants), variantString(nc.ArchVariants); o != n {
+			d.ChangedVariants = append(d.ChangedVariants, Change{File: k.file, IClass: k.id, Old: o, New: n})
+		}
+	}
+
+	for _, f := range old.Files {
+		ni, ok := cur.Lookup(f.Name)
+		if !ok {
+			continue
+		}
+		o, n := strings.Join(f.Insn.Effects(), ","), strings.Join(ni.Effects(), ",")
+		if o != n {
+			d.ChangedEffects = append(d.ChangedEffects, Change{File: f.Name, Old: o, New: n})
+		}
+	}
+
+	oldfeat, newfeat := features(old), features(cur)
+	d.AddedFeatures = setDiff(newfeat, oldfeat)
+	d.RemovedFeatures = setDiff(oldfeat, newfeat)
+
+	for _, cs := range [][]Change{d.ChangedDiagrams, d.ChangedPatterns, d.ChangedVariants} {
+		sort.Slice(cs, func(i, j int) bool {
+			if cs[i].File != cs[j].File {
+				return cs[i].File < cs[j].File
+			}
+			if cs[i].IClass != cs[j].IClass {
+				return cs[i].IClass < cs[j].IClass
+			}
+			return cs[i].Encoding < cs[j].Encoding
+		})
+	}
+	return d
+}
+
+func (d *SpecDiff) WriteText(w io.Writer) {
+	list := func(title, prefix string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "%s:\n", title)
+		for _, it := range items {
+			fmt.Fprintf(w, "\t%s %s\n", prefix, it)
+		}
+	}
+	changes := func(title string, cs []Change) {
+		if len(cs) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "%s:\n", title)
+		for _, c := range cs {
+			name := c.File
+			if c.IClass != "" {
+				name += ": " + c.IClass
+			}
+			if c.Encoding != "" {
+				name += ": " + c.Encoding
+			}
+			fmt.Fprintf(w, "\t~ %s\n\t\t- %s\n\t\t+ %s\n", name, c.Old, c.New)
+		}
+	}
+	list("added instructions", "+", d.AddedInsns)
+	list("removed instructions", "-", d.RemovedInsns)
+	list("added encodings", "+", d.AddedEncodings)
+	list("removed encodings", "-", d.RemovedEncodings)
+	changes("changed encodings", d.ChangedDiagrams)
+	changes("changed encoding patterns", d.ChangedPatterns)
+	changes("changed arch variants", d.ChangedVariants)
+	changes("changed effects", d.ChangedEffects)
+	list("added features", "+", d.AddedFeatures)
+	list("removed features", "-", d.RemovedFeatures)
+}
+
+var diffCmd = &command{
+	name:  "diff",
+	args:  "[-json] OLDDIR NEWDIR",
+	short: "compare two releases of the spec",
+	run:   runDiff,
+}
+
+func runDiff(fs *flag.FlagSet, args []string) error {
+	jsonOut := fs.Bool("json", false, "write output as JSON")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return usagef("expected two spec directories")
+	}
+
+	old, err := LoadSpec(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	cur, err := LoadSpec(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	d := DiffSpecs(old, cur)
+
+	if *jsonOut {
+		return writeJSON(d)
+	}
+	d.WriteText(os.Stdout)
+	return nil
+}
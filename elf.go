@@ -0,0 +1,185 @@
+package main
+
+import (
+	"debug/elf"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+var elfCmd = &command{
+	name:  "elf",
+	args:  "[flags] SPECDIR BINARY...",
+	short: "decode the executable sections of AArch64 ELF binaries and summarize them",
+	run:   runELF,
+}
+
+// scanELF calls fn for every instruction word in the executable sections of
+// an ELF64 AArch64 binary.
+func scanELF(path string, fn func(addr uint64, w uint32)) error {
+	f, err := elf.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if f.Class != elf.ELFCLASS64 || f.Machine != elf.EM_AARCH64 {
+		return fmt.Errorf("%s: not an ELF64 AArch64 file", path)
+	}
+	for _, s := range f.Sections {
+		if s.Type != elf.SHT_PROGBITS || s.Flags&elf.SHF_EXECINSTR == 0 {
+			continue
+		}
+		data, err := s.Data()
+		if err != nil {
+			return fmt.Errorf("%s: %s: %w", path, s.Name, err)
+		}
+		// A64 instructions are little-endian even in big-endian images.
+		for i := 0; i+4 <= len(data); i += 4 {
+			fn(s.Addr+uint64(i), binary.LittleEndian.Uint32(data[i:]))
+		}
+	}
+	return nil
+}
+
+// iclassFeatures returns the features required by an iclass as a single key,
+// with alternatives separated by "|", or "base" if there are none.
+func iclassFeatures(ic *IClass) string {
+	if ic.BaseVariant() {
+		return "base"
+	}
+	var alts []string
+	for _, v := range ic.ArchVariants.Variants {
+		if v.Feature != "" {
+			alts = append(alts, v.Feature)
+		} else {
+			alts = append(alts, v.Name)
+		}
+	}
+	return strings.Join(alts, "|")
+}
+
+func iclassClass(m *Matcher) string {
+	if c := m.IClass.Docs.InstrClass(); c != "" {
+		return c
+	}
+	return m.Insn.Docs.InstrClass()
+}
+
+type Count struct {
+	Key   string
+	Count int
+}
+
+// A Histogram counts occurrences of keys.
+type Histogram map[string]int
+
+// Sorted returns the counts in decreasing order, breaking ties by key.
+func (h Histogram) Sorted() []Count {
+	var cs []Count
+	for k, n := range h {
+		cs = append(cs, Count{k, n})
+	}
+	sort.Slice(cs, func(i, j int) bool {
+		if cs[i].Count != cs[j].Count {
+			return cs[i].Count > cs[j].Count
+		}
+		return cs[i].Key < cs[j].Key
+	})
+	return cs
+}
+
+type BinaryStats struct {
+	File        string
+	Words       int
+	Unallocated int
+	MinVersion  string
+	Features    []Count
+	Classes     []Count
+	Mnemonics   []Count
+}
+
+func (s *BinaryStats) WriteText(w io.Writer) {
+	fmt.Fprintf(w, "%s: %d words, %d unallocated\n", s.File, s.Words, s.Unallocated)
+	fmt.Fprintf(w, "minimum version: %s\n", s.MinVersion)
+	for _, h := range []struct {
+		title  string
+		counts []Count
+	}{
+		{"features", s.Features},
+		{"classes", s.Classes},
+		{"mnemonics", s.Mnemonics},
+	} {
+		fmt.Fprintf(w, "%s:\n", h.title)
+		for _, c := range h.counts {
+			fmt.Fprintf(w, "\t%-24s %d\n", c.Key, c.Count)
+		}
+	}
+}
+
+func runELF(fs *flag.FlagSet, args []string) error {
+	jsonOut := fs.Bool("json", false, "write output as JSON")
+	verbose := fs.Bool("v", false, "print every decoded instruction")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() < 2 {
+		return usagef("expected a spec directory and at least one binary")
+	}
+	dir := fs.Arg(0)
+	model, err := LoadFeatureModel(dir)
+	if err != nil {
+		return err
+	}
+	spec, err := LoadSpec(dir)
+	if err != nil {
+		return err
+	}
+	dec := NewDecoder(NewMatchers(spec))
+
+	var all []*BinaryStats
+	for _, path := range fs.Args()[1:] {
+		stats := &BinaryStats{File: path}
+		features, classes, mnemonics := Histogram{}, Histogram{}, Histogram{}
+		min := Version{8, 0}
+		err := scanELF(path, func(addr uint64, w uint32) {
+			stats.Words++
+			m := dec.Decode(w).Best()
+			if m == nil {
+				stats.Unallocated++
+				if *verbose {
+					fmt.Printf("%x:\t%08x\t(unallocated)\n", addr, w)
+				}
+				return
+			}
+			if *verbose {
+				fmt.Printf("%x:\t%08x\t%s\n", addr, w, m.Mnemonic())
+			}
+			features[iclassFeatures(m.IClass)]++
+			classes[iclassClass(m)]++
+			mnemonics[m.Mnemonic()]++
+			if v := model.MinVersion(*m.IClass); v.after(min) {
+				min = v
+			}
+		})
+		if err != nil {
+			return err
+		}
+		stats.MinVersion = min.String()
+		stats.Features = features.Sorted()
+		stats.Classes = classes.Sorted()
+		stats.Mnemonics = mnemonics.Sorted()
+		all = append(all, stats)
+	}
+
+	if *jsonOut {
+		return writeJSON(all)
+	}
+	for _, s := range all {
+		s.WriteText(os.Stdout)
+	}
+	return nil
+}
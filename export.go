@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+var exportCmd = &command{
+	name:  "export",
+	args:  "(-sql FILE | -sqlite DB) [flags] SPECDIR",
+	short: "export the instruction model as SQL tables",
+	run:   runExport,
+}
+
+const sqlSchema = `CREATE TABLE instructions (
+	id INTEGER PRIMARY KEY,
+	file TEXT NOT NULL,
+	section TEXT NOT NULL,
+	type TEXT NOT NULL,
+	mnemonic TEXT,
+	class TEXT,
+	min_version TEXT
+);
+CREATE TABLE encodings (
+	id INTEGER PRIMARY KEY,
+	instruction_id INTEGER NOT NULL REFERENCES instructions(id),
+	record_id TEXT NOT NULL,
+	iclass TEXT NOT NULL,
+	name TEXT NOT NULL,
+	mnemonic TEXT,
+	class TEXT,
+	asm TEXT,
+	pattern TEXT NOT NULL,
+	mask INTEGER NOT NULL,
+	value INTEGER NOT NULL
+);
+CREATE TABLE fields (
+	encoding_id INTEGER NOT NULL REFERENCES encodings(id),
+	name TEXT,
+	hibit INTEGER NOT NULL,
+	width INTEGER NOT NULL,
+	bits TEXT NOT NULL,
+	"constraint" TEXT
+);
+CREATE TABLE variants (
+	instruction_id INTEGER NOT NULL REFERENCES instructions(id),
+	iclass TEXT NOT NULL,
+	name TEXT,
+	feature TEXT
+);
+CREATE TABLE features (
+	name TEXT PRIMARY KEY,
+	since TEXT,
+	mandatory TEXT
+);
+CREATE TABLE feature_requires (
+	feature TEXT NOT NULL REFERENCES features(name),
+	requires TEXT NOT NULL
+);
+CREATE TABLE effects (
+	instruction_id INTEGER NOT NULL REFERENCES instructions(id),
+	effect TEXT NOT NULL
+);
+CREATE TABLE aliases (
+	encoding_id INTEGER NOT NULL REFERENCES encodings(id),
+	alias TEXT NOT NULL,
+	mnemonic TEXT NOT NULL
+);
+`
+
+// sqlValue formats a Go value as an SQL literal.
+func sqlValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		if v == "" {
+			return "NULL"
+		}
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func sqlInsert(w io.Writer, table string, vals ...interface{}) {
+	s := make([]string, len(vals))
+	for i, v := range vals {
+		s[i] = sqlValue(v)
+	}
+	fmt.Fprintf(w, "INSERT INTO %s VALUES (%s);\n", table, strings.Join(s, ", "))
+}
+
+// WriteSQL writes a dump of the given spec files and feature model that can
+// be loaded into SQLite.
+func WriteSQL(w io.Writer, files []SpecFile, model *FeatureModel) {
+	fmt.Fprintln(w, "BEGIN TRANSACTION;")
+	fmt.Fprint(w, sqlSchema)
+
+	encid := 0
+	for i, f := range files {
+		insn := f.Insn
+		id := i + 1
+		sqlInsert(w, "instructions", id, f.Name, insn.Id, insn.Type, insn.Docs.Mnemonic(),
+			strings.Join(insn.GetClasses(), ";"), model.MinVersionOf(insn).String())
+		for _, e := range insn.Effects() {
+			sqlInsert(w, "effects", id, e)
+		}
+		for _, c := range insn.Classes.IClass {
+			for _, v := range c.ArchVariants.Variants {
+				sqlInsert(w, "variants", id, c.Id, v.Name, v.Feature)
+			}
+			class := c.Docs.InstrClass()
+			if class == "" {
+				class = insn.Docs.InstrClass()
+			}
+			for k := range c.Encodings {
+				e := &c.Encodings[k]
+				encid++
+				p := c.EncodingPattern(e)
+				sqlInsert(w, "encodings", encid, id, RecordID(f.Name, c), c.Id, e.Name,
+					e.Docs.Mnemonic(), class, e.Asm(), c.Bits(e), p.Mask, p.Value)
+				for _, b := range c.EncodingBoxes(e) {
+					sqlInsert(w, "fields", encid, b.Name, b.HiBit, b.Size(), b.Pattern(), strings.TrimSpace(b.Constraint))
+				}
+				if a := e.Docs.AliasMnemonic(); a != "" {
+					sqlInsert(w, "aliases", encid, a, e.Docs.Mnemonic())
+				}
+			}
+		}
+	}
+
+	var names []string
+	for name := range model.Features {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f := model.Features[name]
+		mandatory := ""
+		if f.Mandatory != (Version{}) {
+			mandatory = f.Mandatory.String()
+		}
+		sqlInsert(w, "features", f.Name, f.Since.String(), mandatory)
+		for _, r := range f.Requires {
+			sqlInsert(w, "feature_requires", f.Name, r)
+		}
+	}
+	fmt.Fprintln(w, "COMMIT;")
+}
+
+func runExport(fs *flag.FlagSet, args []string) error {
+	// Export the whole ISA unless filters are given.
+	filters := addFilterFlags(fs, filterDefaults{base: false, classes: "all"})
+	sqlFile := fs.String("sql", "", "write an SQL dump to `file` (- for stdout)")
+	sqliteDB := fs.String("sqlite", "", "create the SQLite database `db` using the sqlite3 command, which must be installed")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if (*sqlFile == "") == (*sqliteDB == "") {
+		return usagef("exactly one of -sql or -sqlite is required")
+	}
+	dir, err := specArg(fs)
+	if err != nil {
+		return err
+	}
+	files, model, err := loadFiltered(dir, filters)
+	if err != nil {
+		return err
+	}
+
+	if *sqliteDB != "" {
+		if _, err := os.Stat(*sqliteDB); err == nil {
+			return fmt.Errorf("%s already exists", *sqliteDB)
+		}
+		cmd := exec.Command("sqlite3", *sqliteDB)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		in, err := cmd.StdinPipe()
+		if err != nil {
+			return err
+		}
+		if err := cmd.Start(); err != nil {
+			return fmt.Errorf("-sqlite needs the sqlite3 command: %w", err)
+		}
+		bw := bufio.NewWriter(in)
+		WriteSQL(bw, files, model)
+		werr := bw.Flush()
+		in.Close()
+		if err := cmd.Wait(); err != nil {
+			return fmt.Errorf("sqlite3: %w", err)
+		}
+		return werr
+	}
+
+	out := os.Stdout
+	if *sqlFile != "-" {
+		out, err = os.Create(*sqlFile)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+	bw := bufio.NewWriter(out)
+	WriteSQL(bw, files, model)
+	return bw.Flush()
+}
@@ -0,0 +1,455 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Feature struct {
+	Name string
+	// Since is the first architecture version in which the feature may be
+	// implemented.
+	Since Version
+	// Mandatory is the version from which the feature is required, or the
+	// zero Version if it is always optional.
+	Mandatory Version
+	Requires  []string
+}
+
+// A FeatureModel describes the relationships between features and
+// architecture versions.
+type FeatureModel struct {
+	Features map[string]*Feature
+}
+
+// cores maps CPU names to the feature list they implement.
+var cores = map[string]string{
+	"cortex-a53":  "armv8-a,FEAT_CRC32,FEAT_AES,FEAT_PMULL,FEAT_SHA1,FEAT_SHA256",
+	"cortex-a55":  "armv8.2-a,FEAT_FP16,FEAT_DotProd,FEAT_LRCPC,FEAT_AES,FEAT_PMULL,FEAT_SHA1,FEAT_SHA256",
+	"cortex-a76":  "armv8.2-a,FEAT_FP16,FEAT_DotProd,FEAT_LRCPC,FEAT_SSBS,FEAT_AES,FEAT_PMULL,FEAT_SHA1,FEAT_SHA256",
+	"neoverse-n1": "armv8.2-a,FEAT_FP16,FEAT_DotProd,FEAT_LRCPC,FEAT_SSBS,FEAT_SPE,FEAT_AES,FEAT_PMULL,FEAT_SHA1,FEAT_SHA256",
+	"neoverse-v1": "armv8.4-a,FEAT_FP16,FEAT_SVE,FEAT_BF16,FEAT_I8MM,FEAT_RNG,FEAT_SSBS,FEAT_SPE,FEAT_AES,FEAT_PMULL,FEAT_SHA1,FEAT_SHA256,FEAT_SHA3,FEAT_SHA512",
+	"cortex-a710": "armv9-a,FEAT_FP16,FEAT_BF16,FEAT_I8MM,FEAT_MTE2,FEAT_SVE_BitPerm,FEAT_FlagM2,FEAT_FRINTTS",
+	"neoverse-n2": "armv9-a,FEAT_FP16,FEAT_BF16,FEAT_I8MM,FEAT_MTE2,FEAT_RNG,FEAT_SVE_BitPerm,FEAT_FlagM2,FEAT_FRINTTS",
+	"neoverse-v2": "armv9-a,FEAT_FP16,FEAT_BF16,FEAT_I8MM,FEAT_MTE2,FEAT_RNG,FEAT_SVE_BitPerm,FEAT_FlagM2,FEAT_FRINTTS",
+}
+
+// introduced lists the version in which optional features were added.
+// Features that are made mandatory by a version are added automatically.
+var introduced = map[Version][]string{
+	{8, 0}: {"FEAT_AES", "FEAT_PMULL", "FEAT_SHA1", "FEAT_SHA256", "FEAT_CRC32"},
+	{8, 2}: {"FEAT_FP16", "FEAT_DotProd", "FEAT_SHA3", "FEAT_SHA512", "FEAT_SM3", "FEAT_SM4", "FEAT_SVE", "FEAT_SPE", "FEAT_FHM"},
+	{8, 3}: {"FEAT_NV"},
+	{8, 4}: {"FEAT_SEL2", "FEAT_AMUv1"},
+	{8, 5}: {"FEAT_MTE", "FEAT_MTE2", "FEAT_MTE3", "FEAT_RNG", "FEAT_SSBS"},
+	{8, 6}: {"FEAT_FPAC", "FEAT_AMUv1p1", "FEAT_F32MM", "FEAT_F64MM"},
+	{8, 7}: {"FEAT_LS64", "FEAT_LS64_V", "FEAT_LS64_ACCDATA", "FEAT_RPRES"},
+	{8, 9}: {"FEAT_LRCPC3"},
+	{9, 0}: {"FEAT_SVE_AES", "FEAT_SVE_BitPerm", "FEAT_SVE_SHA3", "FEAT_SVE_SM4", "FEAT_TME"},
+	{9, 2}: {"FEAT_SME", "FEAT_SME_F64F64", "FEAT_SME_I16I64"},
+	{9, 4}: {"FEAT_SME2", "FEAT_SME2p1", "FEAT_SVE2p1"},
+}
+
+// requires lists the features each feature depends on.
+var requires = map[string][]string{
+	"FEAT_AdvSIMD":      {"FEAT_FP"},
+	"FEAT_FP16":         {"FEAT_FP"},
+	"FEAT_RDM":          {"FEAT_AdvSIMD"},
+	"FEAT_DotProd":      {"FEAT_AdvSIMD"},
+	"FEAT_PMULL":        {"FEAT_AES"},
+	"FEAT_SHA256":       {"FEAT_SHA1"},
+	"FEAT_SHA512":       {"FEAT_SHA256"},
+	"FEAT_SHA3":         {"FEAT_SHA1"},
+	"FEAT_SVE":          {"FEAT_FP16"},
+	"FEAT_SVE2":         {"FEAT_SVE"},
+	"FEAT_SVE2p1":       {"FEAT_SVE2"},
+	"FEAT_SVE_AES":      {"FEAT_SVE2", "FEAT_AES"},
+	"FEAT_SVE_BitPerm":  {"FEAT_SVE2"},
+	"FEAT_SVE_SHA3":     {"FEAT_SVE2", "FEAT_SHA3"},
+	"FEAT_SVE_SM4":      {"FEAT_SVE2", "FEAT_SM4"},
+	"FEAT_F32MM":        {"FEAT_SVE"},
+	"FEAT_F64MM":        {"FEAT_SVE"},
+	"FEAT_SME":          {"FEAT_FP16", "FEAT_BF16"},
+	"FEAT_SME_F64F64":   {"FEAT_SME"},
+	"FEAT_SME_I16I64":   {"FEAT_SME"},
+	"FEAT_SME2":         {"FEAT_SME"},
+	"FEAT_SME2p1":       {"FEAT_SME2"},
+	"FEAT_FlagM2":       {"FEAT_FlagM"},
+	"FEAT_LRCPC2":       {"FEAT_LRCPC"},
+	"FEAT_LRCPC3":       {"FEAT_LRCPC2"},
+	"FEAT_PAuth2":       {"FEAT_PAuth"},
+	"FEAT_FPAC":         {"FEAT_PAuth"},
+	"FEAT_MTE2":         {"FEAT_MTE"},
+	"FEAT_MTE3":         {"FEAT_MTE2"},
+	"FEAT_PAN2":         {"FEAT_PAN"},
+	"FEAT_PAN3":         {"FEAT_PAN2"},
+	"FEAT_DPB2":         {"FEAT_DPB"},
+	"FEAT_RASv1p1":      {"FEAT_RAS"},
+	"FEAT_RASv2":        {"FEAT_RASv1p1"},
+	"FEAT_SPECRES2":     {"FEAT_SPECRES"},
+	"FEAT_LS64_V":       {"FEAT_LS64"},
+	"FEAT_LS64_ACCDATA": {"FEAT_LS64_V"},
+}
+
+func (m *FeatureModel) feature(name string) *Feature {
+	f, ok := m.Features[name]
+	if !ok {
+		f = &Feature{Name: name}
+		m.Features[name] = f
+	}
+	return f
+}
+
+// DefaultFeatureModel returns the bundled feature model.
+func DefaultFeatureModel() *FeatureModel {
+	m := &FeatureModel{Features: make(map[string]*Feature)}
+	for v, feats := range introduced {
+		for _, name := range feats {
+			m.feature(name).Since = v
+		}
+	}
+	for v, feats := range mandatory {
+		for _, name := range feats {
+			f := m.feature(name)
+			f.Mandatory = v
+			if f.Since == (Version{}) || f.Since.after(v) {
+				f.Since = v
+			}
+		}
+	}
+	for name, reqs := range requires {
+		m.feature(name).Requires = reqs
+	}
+	for _, f := range m.Features {
+		if f.Since == (Version{}) {
+			f.Since = Version{8, 0}
+		}
+	}
+	return m
+}
+
+// after reports whether v comes later than o, treating ARMv9.x as aligned
+// with ARMv8.(x+5).
+func (v Version) after(o Version) bool {
+	if c := v.v8().Compare(o.v8()); c != 0 {
+		return c > 0
+	}
+	return v.Major > o.Major
+}
+
+// LoadFeatureModel loads the feature model for the spec in dir. If the spec
+// ships a Features.json (as in the AARCHMRS releases) its constraints are
+// applied on top of the bundled model.
+func LoadFeatureModel(dir string) (*FeatureModel, error) {
+	m := DefaultFeatureModel()
+	data, err := os.ReadFile(filepath.Join(dir, "Features.json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return m, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := m.loadJSON(data); err != nil {
+		return nil, fmt.Errorf("Features.json: %w", err)
+	}
+	return m, nil
+}
+
+type astNode struct {
+	Type  string          `json:"_type"`
+	Op    string          `json:"op"`
+	Left  *astNode        `json:"left"`
+	Right *astNode        `json:"right"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (n *astNode) ident() string {
+	if n == nil || n.Type != "AST.Identifier" {
+		return ""
+	}
+	var s string
+	json.Unmarshal(n.Value, &s)
+	return s
+}
+
+// conjuncts returns the identifiers of an expression of the form
+// "A && B && ...", or nil if it has any other form.
+func (n *astNode) conjuncts() []string {
+	if id := n.ident(); id != "" {
+		return []string{id}
+	}
+	if n == nil || n.Type != "AST.BinaryOp" || n.Op != "&&" {
+		return nil
+	}
+	l, r := n.Left.conjuncts(), n.Right.conjuncts()
+	if l == nil || r == nil {
+		return nil
+	}
+	return append(l, r...)
+}
+
+var versionrx = regexp.MustCompile(`^v(\d+)Ap(\d+)$`)
+
+func parseVersionIdent(s string) (Version, bool) {
+	m := versionrx.FindStringSubmatch(s)
+	if m == nil {
+		return Version{}, false
+	}
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	return Version{major, minor}, true
+}
+
+func (m *FeatureModel) loadJSON(data []byte) error {
+	var doc struct {
+		Parameters []struct {
+			Name        string     `json:"name"`
+			Constraints []*astNode `json:"constraints"`
+		} `json:"parameters"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	for _, p := range doc.Parameters {
+		for _, c := range p.Constraints {
+			if c == nil || c.Type != "AST.BinaryOp" || c.Op != "-->" {
+				continue
+			}
+			lhs := c.Left.ident()
+			rhs := c.Right.conjuncts()
+			if v, ok := parseVersionIdent(lhs); ok {
+				for _, name := range rhs {
+					f := m.feature(name)
+					if f.Mandatory == (Version{}) || f.Mandatory.after(v) {
+						f.Mandatory = v
+					}
+				}
+			} else if strings.HasPrefix(lhs, "FEAT_") {
+				f := m.feature(lhs)
+				for _, name := range rhs {
+					if v, ok := parseVersionIdent(name); ok {
+						f.Since = v
+					} else if strings.HasPrefix(name, "FEAT_") {
+						f.Requires = appendUnique(f.Requires, name)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, x := range list {
+		if x == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+// ProfileFeatures returns the features that are mandatory for the given
+// architecture version.
+func (m *FeatureModel) ProfileFeatures(v Version) []string {
+	var feats []string
+	for _, f := range m.Features {
+		if f.Mandatory != (Version{}) && !f.Mandatory.after(v) && f.Mandatory.Major <= v.Major {
+			feats = append(feats, f.Name)
+		}
+	}
+	sort.Strings(feats)
+	return feats
+}
+
+// Resolve adds the mandatory features of the set's version and every feature
+// implied by a feature already in the set.
+func (m *FeatureModel) Resolve(fs FeatureSet) FeatureSet {
+	for _, f := range m.ProfileFeatures(fs.Version) {
+		fs.Features[f] = true
+	}
+	work := fs.Names()
+	for len(work) > 0 {
+		name := work[len(work)-1]
+		work = work[:len(work)-1]
+		if f, ok := m.Features[name]; ok {
+			for _, r := range f.Requires {
+				if !fs.Features[r] {
+					fs.Features[r] = true
+					work = append(work, r)
+				}
+			}
+		}
+	}
+	return fs
+}
+
+// ParseFeatureSet parses a comma-separated list of FEAT_* names, profile
+// presets such as "armv8.2-a" and core names such as "cortex-a76", and
+// resolves the features they imply.
+func (m *FeatureModel) ParseFeatureSet(s string) (FeatureSet, error) {
+	fs := FeatureSet{
+		Version:  Version{8, 0},
+		Features: make(map[string]bool),
+	}
+	if err := m.addFeatures(&fs, s); err != nil {
+		return FeatureSet{}, err
+	}
+	return m.Resolve(fs), nil
+}
+
+func (m *FeatureModel) addFeatures(fs *FeatureSet, s string) error {
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		switch {
+		case item == "":
+		case strings.HasPrefix(item, "FEAT_"):
+			fs.Features[item] = true
+		case strings.HasPrefix(strings.ToLower(item), "armv"):
+			v, ok := ParseVersion(item)
+			if !ok {
+				return fmt.Errorf("invalid architecture profile %q", item)
+			}
+			if _, ok := mandatory[v.v8()]; !ok || v.Major < 8 || v.Major > 9 {
+				return fmt.Errorf("unknown architecture profile %q", item)
+			}
+			if v.after(fs.Version) {
+				fs.Version = v
+			}
+		case cores[strings.ToLower(item)] != "":
+			if err := m.addFeatures(fs, cores[strings.ToLower(item)]); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown feature %q", item)
+		}
+	}
+	return nil
+}
+
+// MinVersion returns the earliest architecture version in which the iclass
+// can be implemented.
+func (m *FeatureModel) MinVersion(ic IClass) Version {
+	if ic.BaseVariant() {
+		return Version{8, 0}
+	}
+	var min Version
+	for i, v := range ic.ArchVariants.Variants {
+		var vv Version
+		if f, ok := m.Features[v.Feature]; ok {
+			vv = f.Since
+		} else if pv, ok := ParseVersion(v.Name); ok {
+			vv = pv
+		} else {
+			vv = Version{8, 0}
+		}
+		if i == 0 || min.after(vv) {
+			min = vv
+		}
+	}
+	return min
+}
+
+// MinVersionOf returns the earliest architecture version in which any form of
+// the instruction can be implemented.
+func (m *FeatureModel) MinVersionOf(is InsnSection) Version {
+	var min Version
+	for i, c := range is.Classes.IClass {
+		if v := m.MinVersion(c); i == 0 || min.after(v) {
+			min = v
+		}
+	}
+	return min
+}
+
+// AvailableIn reports whether any form of the instruction can be implemented
+// in architecture version v. ARMv9.x includes ARMv8.x only up to the version
+// it is aligned with.
+func (m *FeatureModel) AvailableIn(is InsnSection, v Version) bool {
+	for _, c := range is.Classes.IClass {
+		if min := m.MinVersion(c); min.Major <= v.Major && min.v8().Compare(v.v8()) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// extensions maps the architecture extension names used in compiler -march
+// options to features.
+var extensions = map[string]string{
+	"fp":           "FEAT_FP",
+	"simd":         "FEAT_AdvSIMD",
+	"crc":          "FEAT_CRC32",
+	"crypto":       "FEAT_AES,FEAT_PMULL,FEAT_SHA1,FEAT_SHA256",
+	"aes":          "FEAT_AES,FEAT_PMULL",
+	"sha2":         "FEAT_SHA1,FEAT_SHA256",
+	"sha3":         "FEAT_SHA3,FEAT_SHA512",
+	"sm4":          "FEAT_SM3,FEAT_SM4",
+	"lse":          "FEAT_LSE",
+	"rdma":         "FEAT_RDM",
+	"fp16":         "FEAT_FP16",
+	"fp16fml":      "FEAT_FHM",
+	"dotprod":      "FEAT_DotProd",
+	"rcpc":         "FEAT_LRCPC",
+	"rcpc3":        "FEAT_LRCPC3",
+	"flagm":        "FEAT_FlagM",
+	"pauth":        "FEAT_PAuth",
+	"sb":           "FEAT_SB",
+	"ssbs":         "FEAT_SSBS",
+	"predres":      "FEAT_SPECRES",
+	"rng":          "FEAT_RNG",
+	"memtag":       "FEAT_MTE,FEAT_MTE2",
+	"bf16":         "FEAT_BF16",
+	"i8mm":         "FEAT_I8MM",
+	"f32mm":        "FEAT_F32MM",
+	"f64mm":        "FEAT_F64MM",
+	"sve":          "FEAT_SVE",
+	"sve2":         "FEAT_SVE2",
+	"sve2-aes":     "FEAT_SVE_AES",
+	"sve2-bitperm": "FEAT_SVE_BitPerm",
+	"sve2-sha3":    "FEAT_SVE_SHA3",
+	"sve2-sm4":     "FEAT_SVE_SM4",
+	"sve2p1":       "FEAT_SVE2p1",
+	"sme":          "FEAT_SME",
+	"sme-f64f64":   "FEAT_SME_F64F64",
+	"sme-i16i64":   "FEAT_SME_I16I64",
+	"sme2":         "FEAT_SME2",
+	"tme":          "FEAT_TME",
+	"ls64":         "FEAT_LS64,FEAT_LS64_V,FEAT_LS64_ACCDATA",
+	"mops":         "FEAT_MOPS",
+	"cssc":         "FEAT_CSSC",
+}
+
+// ParseTarget parses a target in the style of a compiler -march option, such
+// as "armv8.2-a+sve+lse". The base may also be a core name or a
+// comma-separated feature list, and extensions may be FEAT_* names.
+func (m *FeatureModel) ParseTarget(s string) (FeatureSet, error) {
+	parts := strings.Split(s, "+")
+	fs := FeatureSet{
+		Version:  Version{8, 0},
+		Features: make(map[string]bool),
+	}
+	if err := m.addFeatures(&fs, parts[0]); err != nil {
+		return FeatureSet{}, err
+	}
+	for _, ext := range parts[1:] {
+		if strings.HasPrefix(ext, "FEAT_") {
+			fs.Features[ext] = true
+			continue
+		}
+		feats, ok := extensions[strings.ToLower(ext)]
+		if !ok {
+			return FeatureSet{}, fmt.Errorf("unknown extension %q", ext)
+		}
+		if err := m.addFeatures(&fs, feats); err != nil {
+			return FeatureSet{}, err
+		}
+	}
+	return m.Resolve(fs), nil
+}
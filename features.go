@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A Version is an architecture version such as ARMv8.2.
+type Version struct {
+	Major int
+	Minor int
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("ARMv%d.%d", v.Major, v.Minor)
+}
+
+func (v Version) Compare(o Version) int {
+	switch {
+	case v.Major != o.Major:
+		return v.Major - o.Major
+	default:
+		return v.Minor - o.Minor
+	}
+}
+
+// ParseVersion parses architecture version names as they appear in the spec
+// ("ARMv8.2") and on the command line ("armv8.2-a", "v9.4", "8.1").
+func ParseVersion(s string) (Version, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.TrimPrefix(s, "arm")
+	s = strings.TrimPrefix(s, "v")
+	s = strings.TrimSuffix(s, "-a")
+	major, minor, _ := strings.Cut(s, ".")
+	var v Version
+	var err error
+	if v.Major, err = strconv.Atoi(major); err != nil {
+		return Version{}, false
+	}
+	if minor != "" {
+		if v.Minor, err = strconv.Atoi(minor); err != nil {
+			return Version{}, false
+		}
+	}
+	return v, true
+}
+
+// v8 returns the ARMv8.x version that an ARMv9.x version is aligned with.
+func (v Version) v8() Version {
+	if v.Major == 9 {
+		return Version{8, v.Minor + 5}
+	}
+	return v
+}
+
+// mandatory lists the features each ARMv8.x version makes mandatory on top of
+// the previous one. ARMv9.x includes ARMv8.(x+5) plus the features listed
+// under major version 9.
+var mandatory = map[Version][]string{
+	{8, 0}: {"FEAT_AdvSIMD", "FEAT_FP"},
+	{8, 1}: {"FEAT_LSE", "FEAT_RDM", "FEAT_CRC32", "FEAT_PAN", "FEAT_LOR", "FEAT_HPDS", "FEAT_VHE", "FEAT_VMID16"},
+	{8, 2}: {"FEAT_ASMv8p2", "FEAT_DPB", "FEAT_PAN2", "FEAT_UAO", "FEAT_TTCNP", "FEAT_XNX", "FEAT_RAS", "FEAT_IESB", "FEAT_LSMAOC", "FEAT_HPDS2", "FEAT_Debugv8p2"},
+	{8, 3}: {"FEAT_PAuth", "FEAT_JSCVT", "FEAT_FCMA", "FEAT_LRCPC"},
+	{8, 4}: {"FEAT_DotProd", "FEAT_LRCPC2", "FEAT_FlagM", "FEAT_LSE2", "FEAT_TLBIOS", "FEAT_TLBIRANGE", "FEAT_DIT", "FEAT_TTL", "FEAT_S2FWB", "FEAT_IDST", "FEAT_RASv1p1", "FEAT_Debugv8p4"},
+	{8, 5}: {"FEAT_FlagM2", "FEAT_FRINTTS", "FEAT_SB", "FEAT_SPECRES", "FEAT_BTI", "FEAT_CSV2", "FEAT_CSV3", "FEAT_DPB2", "FEAT_E0PD"},
+	{8, 6}: {"FEAT_BF16", "FEAT_I8MM", "FEAT_ECV", "FEAT_FGT", "FEAT_PAuth2"},
+	{8, 7}: {"FEAT_WFxT", "FEAT_HCX", "FEAT_XS", "FEAT_PAN3", "FEAT_AFP"},
+	{8, 8}: {"FEAT_MOPS", "FEAT_HBC", "FEAT_NMI", "FEAT_TIDCP1"},
+	{8, 9}: {"FEAT_CSSC", "FEAT_CLRBHB", "FEAT_PRFMSLC", "FEAT_SPECRES2", "FEAT_RASv2"},
+	{9, 0}: {"FEAT_SVE", "FEAT_SVE2", "FEAT_ETE", "FEAT_TRBE"},
+}
+
+// A FeatureSet is the set of features and the architecture version that a
+// target implements.
+type FeatureSet struct {
+	Version  Version
+	Features map[string]bool
+}
+
+func (fs FeatureSet) Has(feat string) bool {
+	return fs.Features[feat]
+}
+
+func (fs FeatureSet) Names() []string {
+	var names []string
+	for f := range fs.Features {
+		names = append(names, f)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Supports reports whether an arch variant is implemented. A variant naming a
+// feature requires that feature, otherwise it requires the named version.
+func (fs FeatureSet) Supports(v ArchVariant) bool {
+	if v.Feature != "" {
+		return fs.Has(v.Feature)
+	}
+	vv, ok := ParseVersion(v.Name)
+	if !ok {
+		return false
+	}
+	if vv.Major > fs.Version.Major {
+		return false
+	}
+	return vv.v8().Compare(fs.Version.v8()) <= 0
+}
+
+func (ic IClass) SupportedBy(fs FeatureSet) bool {
+	if ic.BaseVariant() {
+		return true
+	}
+	for _, v := range ic.ArchVariants.Variants {
+		if fs.Supports(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (is InsnSection) SupportedBy(fs FeatureSet) bool {
+	for _, c := range is.Classes.IClass {
+		if c.SupportedBy(fs) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"strings"
+)
+
+type filterFlags struct {
+	base     *bool
+	classes  *string
+	branch   *bool
+	rdmem    *bool
+	wrmem    *bool
+	atomic   *bool
+	nomodify *bool
+	variant  *string
+	features *string
+	where    *string
+}
+
+// filterDefaults are the defaults of the filter flags that differ between
+// commands.
+type filterDefaults struct {
+	base    bool
+	classes string
+}
+
+// baseFilters restricts commands to the ARMv8.0 base classes by default.
+var baseFilters = filterDefaults{base: true, classes: InstrBase}
+
+func addFilterFlags(fs *flag.FlagSet, def filterDefaults) *filterFlags {
+	return &filterFlags{
+		base:     fs.Bool("base", def.base, "only consider instructions from the ARMv8.0 instruction set"),
+		classes:  fs.String("classes", def.classes, "comma-separated list of instruction classes, or \"all\""),
+		branch:   fs.Bool("branch", false, "only show branch instructions"),
+		rdmem:    fs.Bool("rdmem", false, "only show instructions that read from memory"),
+		wrmem:    fs.Bool("wrmem", false, "only show instructions that write to memory"),
+		atomic:   fs.Bool("atomic", false, "only show atomic instructions"),
+		nomodify: fs.Bool("nomodify", false, "only show instructions that do not modify any general-purpose registers"),
+		variant:  fs.String("variant", "", "only show instructions available in the given ISA version"),
+		features: fs.String("features", "", "only show instructions supported by the comma-separated `list` of FEAT_* features, profiles (e.g. armv8.2-a) and cores (e.g. cortex-a76); implies -base=false"),
+		where:    fs.String("where", "", "only show instructions matching the filter `expression`"),
+	}
+}
+
+// A Filter selects instructions according to the filter flags.
+type Filter struct {
+	flags      *filterFlags
+	model      *FeatureModel
+	maxVersion Version
+	featset    FeatureSet
+	query      Expr
+}
+
+func (f *filterFlags) Filter(model *FeatureModel) (*Filter, error) {
+	filt := &Filter{flags: f, model: model}
+	if *f.variant != "" {
+		v, ok := ParseVersion(*f.variant)
+		if !ok {
+			return nil, usagef("-variant: invalid version %q", *f.variant)
+		}
+		filt.maxVersion = v
+	}
+	if *f.features != "" {
+		fs, err := model.ParseFeatureSet(*f.features)
+		if err != nil {
+			return nil, usagef("-features: %v", err)
+		}
+		filt.featset = fs
+	}
+	if *f.where != "" {
+		q, err := ParseQuery(*f.where)
+		if err != nil {
+			return nil, usagef("-where: %v", err)
+		}
+		filt.query = q
+	}
+	return filt, nil
+}
+
+func (f *Filter) Match(file string, insn InsnSection) bool {
+	flags := f.flags
+	if *flags.base && *flags.features == "" && !insn.BaseVariant() {
+		return false
+	}
+	hasclass := false
+	for _, class := range strings.Split(*flags.classes, ",") {
+		if insn.HasClass(class) {
+			hasclass = true
+		}
+	}
+	if !hasclass {
+		return false
+	}
+	if *flags.branch && !insn.IsBranch() {
+		return false
+	}
+	if *flags.rdmem && !insn.ReadsMem() {
+		return false
+	}
+	if *flags.wrmem && !insn.WritesMem() {
+		return false
+	}
+	if *flags.atomic && !insn.MemAtomic() {
+		return false
+	}
+	if *flags.nomodify && len(insn.WriteSet()) != 0 {
+		return false
+	}
+	if *flags.variant != "" && !f.model.AvailableIn(insn, f.maxVersion) {
+		return false
+	}
+	if *flags.features != "" && !insn.SupportedBy(f.featset) {
+		return false
+	}
+	if f.query != nil && !f.query.Eval(&Subject{File: file, Insn: insn, Model: f.model}) {
+		return false
+	}
+	return true
+}
+
+// loadFiltered loads the spec in dir and returns the files that pass the
+// filter flags, along with the spec's feature model.
+func loadFiltered(dir string, flags *filterFlags) ([]SpecFile, *FeatureModel, error) {
+	model, err := LoadFeatureModel(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	filt, err := flags.Filter(model)
+	if err != nil {
+		return nil, nil, err
+	}
+	spec, err := LoadSpec(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	var files []SpecFile
+	for _, f := range spec.Files {
+		if filt.Match(f.Name, f.Insn) {
+			files = append(files, f)
+		}
+	}
+	return files, model, nil
+}
+
+// specArg returns the single spec directory argument of a command.
+func specArg(fs *flag.FlagSet) (string, error) {
+	if fs.NArg() != 1 {
+		return "", usagef("expected a spec directory")
+	}
+	return fs.Arg(0), nil
+}
+
+// errBadFlags is returned by parseFlags after the flag package has already
+// reported the problem.
+var errBadFlags = errors.New("bad flags")
+
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return err
+		}
+		return errBadFlags
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"testing"
+)
+
+func TestFilterVariant(t *testing.T) {
+	model := DefaultFeatureModel()
+	insns := map[string]InsnSection{
+		"add":      testInsn(t, "ADD", "general", "", ""),
+		"ldadd":    testInsn(t, "LDADD", "general", "FEAT_LSE", ""),
+		"add_z":    testInsn(t, "ADD", "sve", "FEAT_SVE", ""),
+		"sqrdmlah": testInsn(t, "SQRDMLAH", "sve2", "FEAT_SVE2", ""),
+	}
+	tests := []struct {
+		variant string
+		want    map[string]bool
+	}{
+		{"armv8.0", map[string]bool{"add": true}},
+		{"armv8.1", map[string]bool{"add": true, "ldadd": true}},
+		{"armv8.2", map[string]bool{"add": true, "ldadd": true, "add_z": true}},
+		{"armv8.9", map[string]bool{"add": true, "ldadd": true, "add_z": true}},
+		{"armv9.4", map[string]bool{"add": true, "ldadd": true, "add_z": true, "sqrdmlah": true}},
+	}
+	for _, tt := range tests {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		flags := addFilterFlags(fs, baseFilters)
+		if err := fs.Parse([]string{"-base=false", "-classes", "all", "-variant", tt.variant}); err != nil {
+			t.Fatal(err)
+		}
+		filt, err := flags.Filter(model)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name, insn := range insns {
+			if got := filt.Match(name+".xml", insn); got != tt.want[name] {
+				t.Errorf("-variant %s: %s matched %v, want %v", tt.variant, name, got, tt.want[name])
+			}
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// A column is a record field that can be selected for output.
+type column struct {
+	name  string
+	field string
+	get   func(r *Record) interface{}
+}
+
+func (c column) str(r *Record) string {
+	return fmt.Sprint(c.get(r))
+}
+
+var columns = []column{
+	{"id", "ID", func(r *Record) interface{} { return r.ID }},
+	{"file", "File", func(r *Record) interface{} { return r.File }},
+	{"name", "Name", func(r *Record) interface{} { return r.Name }},
+	{"iclass", "IClass", func(r *Record) interface{} { return r.IClass }},
+	{"path", "Path", func(r *Record) interface{} { return r.Path }},
+	{"variants", "Variants", func(r *Record) interface{} { return r.Variants }},
+	{"features", "Features", func(r *Record) interface{} { return r.Features }},
+	{"class", "InstrClass", func(r *Record) interface{} { return r.InstrClass }},
+	{"diagram", "RegDiagram", func(r *Record) interface{} { return r.RegDiagram }},
+	{"base", "Base", func(r *Record) interface{} { return r.Base }},
+	{"minversion", "MinVersion", func(r *Record) interface{} { return r.MinVersion }},
+	{"effects", "Effects", func(r *Record) interface{} { return r.Effects }},
+}
+
+var formats = []string{"text", "csv", "tsv", "json", "jsonl", "yaml"}
+
+func validFormat(format string) bool {
+	for _, f := range formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+func columnNames() string {
+	var names []string
+	for _, c := range columns {
+		names = append(names, c.name)
+	}
+	return strings.Join(names, ",")
+}
+
+func parseColumns(s string) ([]column, error) {
+	var cols []column
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, c := range columns {
+			if c.name == name {
+				cols = append(cols, c)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column %q (have %s)", name, columnNames())
+		}
+	}
+	return cols, nil
+}
+
+// sortRecords sorts records by the given columns in order, then by ID.
+func sortRecords(records []Record, by []column) {
+	sort.SliceStable(records, func(i, j int) bool {
+		for _, c := range by {
+			a, b := c.str(&records[i]), c.str(&records[j])
+			if a != b {
+				return a < b
+			}
+		}
+		return records[i].ID < records[j].ID
+	})
+}
+
+// jsonObject formats the selected columns of a record as a JSON object with
+// the same keys as the Record struct.
+func jsonObject(r *Record, cols []column) json.RawMessage {
+	var fields []string
+	for _, c := range cols {
+		k, _ := json.Marshal(c.field)
+		v, _ := json.Marshal(c.get(r))
+		fields = append(fields, fmt.Sprintf("%s:%s", k, v))
+	}
+	return json.RawMessage("{" + strings.Join(fields, ",") + "}")
+}
+
+// WriteRecords writes the given columns of records in one of formats. Every
+// format but json, jsonl and yaml starts with a header row.
+func WriteRecords(w io.Writer, format string, records []Record, cols []column) error {
+	switch format {
+	case "text":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		var header []string
+		for _, c := range cols {
+			header = append(header, strings.ToUpper(c.name))
+		}
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+		for i := range records {
+			var row []string
+			for _, c := range cols {
+				row = append(row, c.str(&records[i]))
+			}
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	case "csv", "tsv":
+		cw := csv.NewWriter(w)
+		if format == "tsv" {
+			cw.Comma = '\t'
+		}
+		var header []string
+		for _, c := range cols {
+			header = append(header, c.name)
+		}
+		cw.Write(header)
+		for i := range records {
+			var row []string
+			for _, c := range cols {
+				row = append(row, c.str(&records[i]))
+			}
+			cw.Write(row)
+		}
+		cw.Flush()
+		return cw.Error()
+	case "json":
+		objs := make([]json.RawMessage, len(records))
+		for i := range records {
+			objs[i] = jsonObject(&records[i], cols)
+		}
+		b, err := json.MarshalIndent(objs, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return err
+	case "jsonl":
+		for i := range records {
+			if _, err := fmt.Fprintln(w, string(jsonObject(&records[i], cols))); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "yaml":
+		for i := range records {
+			for j, c := range cols {
+				prefix := "  "
+				if j == 0 {
+					prefix = "- "
+				}
+				v := c.get(&records[i])
+				if s, ok := v.(string); ok {
+					v = strconv.Quote(s)
+				}
+				fmt.Fprintf(w, "%s%s: %v\n", prefix, c.name, v)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown format %q (have %s)", format, strings.Join(formats, ", "))
+}
@@ -0,0 +1,475 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A WordRange is the block of words sharing a prefix, written like an IPv4
+// CIDR block: 0x8b000000/8 is every word whose top 8 bits are 0x8b.
+type WordRange struct {
+	Base uint32
+	Bits int
+}
+
+func ParseWordRange(s string) (WordRange, error) {
+	base, bits, ok := strings.Cut(s, "/")
+	w, okw := parseWord(base)
+	n, err := strconv.Atoi(bits)
+	if !ok || !okw || err != nil || n < 0 || n > 32 {
+		return WordRange{}, fmt.Errorf("invalid range %q, want WORD/BITS", s)
+	}
+	r := WordRange{Bits: n}
+	if n > 0 {
+		r.Base = w &^ (1<<uint(32-n) - 1)
+	}
+	return r, nil
+}
+
+func (r WordRange) String() string {
+	return fmt.Sprintf("%08x/%d", r.Base, r.Bits)
+}
+
+// hilbert converts a distance along the Hilbert curve filling a side×side
+// square into coordinates.
+func hilbert(side, d int) (x, y int) {
+	for s := 1; s < side; s *= 2 {
+		rx := 1 & (d / 2)
+		ry := 1 & (d ^ rx)
+		if ry == 0 {
+			if rx == 1 {
+				x, y = s-1-x, s-1-y
+			}
+			x, y = y, x
+		}
+		x += s * rx
+		y += s * ry
+		d /= 4
+	}
+	return x, y
+}
+
+// categorize assigns each record to a category by the given key, returning
+// the category names and the category index of each record. Unallocated
+// words are the last category.
+func categorize(records []Record, by string) ([]string, []int, error) {
+	key, err := recordKey(by)
+	if err != nil {
+		return nil, nil, err
+	}
+	index := make(map[string]int)
+	var names []string
+	cats := make([]int, len(records))
+	for i := range records {
+		k := key(&records[i])
+		c, ok := index[k]
+		if !ok {
+			c = len(names)
+			index[k] = c
+			names = append(names, k)
+		}
+		cats[i] = c
+	}
+	return append(names, "unallocated"), cats, nil
+}
+
+// recordKeys are the keys records can be grouped by.
+var recordKeys = []string{"class", "feature", "variant", "file", "mnemonic", "effect", "alloc"}
+
+func recordKey(by string) (func(r *Record) string, error) {
+	switch by {
+	case "class":
+		return func(r *Record) string { return r.InstrClass }, nil
+	case "feature":
+		return func(r *Record) string {
+			if r.Features == "" {
+				return "base"
+			}
+			return r.Features
+		}, nil
+	case "variant":
+		return func(r *Record) string { return r.MinVersion }, nil
+	case "file":
+		return func(r *Record) string { return r.File }, nil
+	case "mnemonic":
+		return func(r *Record) string { return r.Name }, nil
+	case "effect":
+		return func(r *Record) string {
+			effects := ";" + r.Effects + ";"
+			switch {
+			case strings.Contains(effects, ";branch;"):
+				return "branch"
+			case strings.Contains(effects, "_mem;"), strings.Contains(effects, ";atomic;"):
+				return "memory"
+			}
+			return "other"
+		}, nil
+	case "alloc":
+		return func(r *Record) string { return "allocated" }, nil
+	}
+	return nil, fmt.Errorf("unknown grouping %q", by)
+}
+
+// categoryColor returns distinct colors for successive categories by
+// stepping the hue by the golden ratio.
+func categoryColor(i int) color.RGBA {
+	h := math.Mod(float64(i)*0.618033988749895, 1) * 6
+	s, v := 0.65, 0.95
+	f := h - math.Floor(h)
+	p, q, t := v*(1-s), v*(1-s*f), v*(1-s*(1-f))
+	var r, g, b float64
+	switch int(h) {
+	case 0:
+		r, g, b = v, t, p
+	case 1:
+		r, g, b = q, v, p
+	case 2:
+		r, g, b = p, v, t
+	case 3:
+		r, g, b = p, q, v
+	case 4:
+		r, g, b = t, p, v
+	default:
+		r, g, b = v, p, q
+	}
+	return color.RGBA{uint8(r * 255), uint8(g * 255), uint8(b * 255), 255}
+}
+
+// A Heatmap assigns each pixel the category that covers most of the block of
+// words it stands for.
+type Heatmap struct {
+	Range  WordRange
+	Side   int
+	Names  []string
+	Counts []uint64 // words per category
+	Pixels []int    // category of each pixel, in Hilbert order
+}
+
+// NewHeatmap computes the heatmap of the words in r from the table, using at
+// most size×size pixels.
+func NewHeatmap(t *Table, cats []int, names []string, r WordRange, size int) (*Heatmap, error) {
+	h, shift := newHeatmap(names, r, size)
+	unalloc := len(names) - 1
+	category := func(v int32) int {
+		if v < 0 {
+			return unalloc
+		}
+		return cats[v]
+	}
+
+	counts := make([]uint64, len(names))
+	for d := range h.Pixels {
+		for i := range counts {
+			counts[i] = 0
+		}
+		lo := uint64(r.Base) + uint64(d)<<shift
+		hi := lo + 1<<shift
+		for w := lo; w < hi; {
+			p := int(w >> PageBits)
+			end := uint64(p+1) << PageBits
+			if end > hi {
+				end = hi
+			}
+			if v, ok := t.Uniform(p); ok {
+				counts[category(v)] += end - w
+			} else {
+				vals, err := t.Page(p)
+				if err != nil {
+					return nil, err
+				}
+				for _, v := range vals[w&(PageSize-1) : w&(PageSize-1)+(end-w)] {
+					counts[category(v)]++
+				}
+			}
+			w = end
+		}
+		best := 0
+		for i, n := range counts {
+			h.Counts[i] += n
+			if n > counts[best] {
+				best = i
+			}
+		}
+		h.Pixels[d] = best
+	}
+	return h, nil
+}
+
+// newHeatmap returns an empty heatmap of r with at most size×size pixels and
+// the log2 of the number of words per pixel.
+func newHeatmap(names []string, r WordRange, size int) (*Heatmap, uint) {
+	span := 32 - r.Bits
+	k := 0
+	for 1<<uint(k+1) <= size && 2*(k+1) <= span {
+		k++
+	}
+	h := &Heatmap{Range: r, Side: 1 << uint(k), Names: names, Counts: make([]uint64, len(names))}
+	h.Pixels = make([]int, h.Side*h.Side)
+	return h, uint(span - 2*k)
+}
+
+// NewPatternHeatmap computes the heatmap of the words in r from the records'
+// diagrams rather than a table. Words matched by records of several
+// categories count towards each of them.
+func NewPatternHeatmap(records []Record, cats []int, names []string, r WordRange, size int) (*Heatmap, error) {
+	ps, err := recordPatterns(records)
+	if err != nil {
+		return nil, err
+	}
+	h, shift := newHeatmap(names, r, size)
+	members := make([][]Pattern, len(names))
+	for i, c := range cats {
+		members[c] = append(members[c], ps[i])
+	}
+	sets := make([][]Cube, len(names))
+	for c := range members[:len(names)-1] {
+		sets[c] = PatternSet(members[c])
+	}
+	sets[len(names)-1] = PatternSet(ps).Complement()
+
+	depth := uint(32-r.Bits) - shift
+	counts := make([]uint64, len(names))
+	var walk func(sets [][]Cube, node Cube, level uint, d int)
+	walk = func(sets [][]Cube, node Cube, level uint, d int) {
+		live := make([][]Cube, len(sets))
+		full := false
+		for c, cs := range sets {
+			l, cov := restrict(cs, node)
+			live[c] = l
+			full = full || cov == coverFull
+		}
+		// Once a category covers the whole node, every pixel in it
+		// goes to the largest category, but the others still count.
+		if full || level == depth {
+			best := 0
+			for c, cs := range live {
+				counts[c] = CubeSet(cs).Within(node).Count()
+				h.Counts[c] += counts[c]
+				if counts[c] > counts[best] {
+					best = c
+				}
+			}
+			n := 1 << (depth - level)
+			for p := d * n; p < (d+1)*n; p++ {
+				h.Pixels[p] = best
+			}
+			return
+		}
+		bit := uint32(1) << (31 - uint(r.Bits) - level)
+		walk(live, Cube{node.Mask | bit, node.Value}, level+1, 2*d)
+		walk(live, Cube{node.Mask | bit, node.Value | bit}, level+1, 2*d+1)
+	}
+	root := Cube{Value: r.Base}
+	if r.Bits > 0 {
+		root.Mask = ^uint32(0) << uint(32-r.Bits)
+	}
+	walk(sets, root, 0, 0)
+	return h, nil
+}
+
+// WriteBlocks writes, for each block of words with an allocated word, the
+// block's first word, its dominant category and the histogram of its
+// categories, largest first.
+func WriteBlocks(w io.Writer, t *Table, cats []int, names []string, block int) error {
+	unalloc := len(names) - 1
+	counts := make([]int, len(names))
+	order := make([]int, len(names))
+	for p := 0; p < PageCount; p++ {
+		if v, ok := t.Uniform(p); ok && v < 0 {
+			continue
+		}
+		vals, err := t.Page(p)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(vals); i += block {
+			for c := range counts {
+				counts[c] = 0
+				order[c] = c
+			}
+			for _, v := range vals[i : i+block] {
+				if v < 0 {
+					counts[unalloc]++
+				} else {
+					counts[cats[v]]++
+				}
+			}
+			if counts[unalloc] == block {
+				continue
+			}
+			sort.SliceStable(order, func(i, j int) bool {
+				return counts[order[i]] > counts[order[j]]
+			})
+			fmt.Fprintf(w, "%08x %s", p*PageSize+i, names[order[0]])
+			for _, c := range order {
+				if counts[c] == 0 {
+					break
+				}
+				fmt.Fprintf(w, " %s=%d", names[c], counts[c])
+			}
+			fmt.Fprintln(w)
+		}
+	}
+	return nil
+}
+
+// Legend returns the categories present in the heatmap, largest first.
+func (h *Heatmap) Legend() []int {
+	var cats []int
+	for i, n := range h.Counts {
+		if n != 0 {
+			cats = append(cats, i)
+		}
+	}
+	sort.SliceStable(cats, func(i, j int) bool {
+		return h.Counts[cats[i]] > h.Counts[cats[j]]
+	})
+	return cats
+}
+
+const legendScale = 2
+
+// Image renders the heatmap with the legend to its right. Unallocated words
+// are black.
+func (h *Heatmap) Image() *image.RGBA {
+	legend := h.Legend()
+	colors := make([]color.RGBA, len(h.Names))
+	for i, c := range legend {
+		colors[c] = categoryColor(i)
+	}
+	colors[len(h.Names)-1] = color.RGBA{0, 0, 0, 255}
+
+	total := uint64(0)
+	for _, n := range h.Counts {
+		total += n
+	}
+	var labels []string
+	width := 0
+	for _, c := range legend {
+		l := fmt.Sprintf("%s %.2f%%", h.Names[c], float64(h.Counts[c])/float64(total)*100)
+		labels = append(labels, l)
+		if n := len(l); n > width {
+			width = n
+		}
+	}
+	title := h.Range.String()
+	if len(title) > width {
+		width = len(title)
+	}
+	line := (glyphHeight + 2) * legendScale
+	lw := (width*(glyphWidth+1)+glyphHeight+4)*legendScale + 8
+	height := h.Side
+	if lh := (len(legend)+2)*line + 8; lh > height {
+		height = lh
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, h.Side+lw, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	for d, c := range h.Pixels {
+		x, y := hilbert(h.Side, d)
+		img.SetRGBA(x, y, colors[c])
+	}
+
+	x0, y := h.Side+8, 4
+	drawText(img, x0, y, title, color.Black)
+	y += 2 * line
+	for i, c := range legend {
+		sw := glyphHeight * legendScale
+		draw.Draw(img, image.Rect(x0, y, x0+sw, y+sw), image.NewUniform(colors[c]), image.Point{}, draw.Src)
+		drawText(img, x0+sw+4*legendScale, y, labels[i], color.Black)
+		y += line
+	}
+	return img
+}
+
+func writePNG(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// A tiny 3×5 bitmap font for the legend, one string per row. Lower case
+// letters are drawn as upper case, and unknown characters as spaces.
+const (
+	glyphWidth  = 3
+	glyphHeight = 5
+)
+
+var glyphs = map[rune][glyphHeight]string{
+	'A': {".#.", "#.#", "###", "#.#", "#.#"},
+	'B': {"##.", "#.#", "##.", "#.#", "##."},
+	'C': {".##", "#..", "#..", "#..", ".##"},
+	'D': {"##.", "#.#", "#.#", "#.#", "##."},
+	'E': {"###", "#..", "##.", "#..", "###"},
+	'F': {"###", "#..", "##.", "#..", "#.."},
+	'G': {".##", "#..", "#.#", "#.#", ".##"},
+	'H': {"#.#", "#.#", "###", "#.#", "#.#"},
+	'I': {"###", ".#.", ".#.", ".#.", "###"},
+	'J': {"..#", "..#", "..#", "#.#", ".#."},
+	'K': {"#.#", "#.#", "##.", "#.#", "#.#"},
+	'L': {"#..", "#..", "#..", "#..", "###"},
+	'M': {"#.#", "###", "###", "#.#", "#.#"},
+	'N': {"##.", "#.#", "#.#", "#.#", "#.#"},
+	'O': {".#.", "#.#", "#.#", "#.#", ".#."},
+	'P': {"##.", "#.#", "##.", "#..", "#.."},
+	'Q': {".#.", "#.#", "#.#", "##.", ".##"},
+	'R': {"##.", "#.#", "##.", "#.#", "#.#"},
+	'S': {".##", "#..", ".#.", "..#", "##."},
+	'T': {"###", ".#.", ".#.", ".#.", ".#."},
+	'U': {"#.#", "#.#", "#.#", "#.#", "###"},
+	'V': {"#.#", "#.#", "#.#", "#.#", ".#."},
+	'W': {"#.#", "#.#", "###", "###", "#.#"},
+	'X': {"#.#", "#.#", ".#.", "#.#", "#.#"},
+	'Y': {"#.#", "#.#", ".#.", ".#.", ".#."},
+	'Z': {"###", "..#", ".#.", "#..", "###"},
+	'0': {"###", "#.#", "#.#", "#.#", "###"},
+	'1': {".#.", "##.", ".#.", ".#.", "###"},
+	'2': {"##.", "..#", ".#.", "#..", "###"},
+	'3': {"##.", "..#", ".#.", "..#", "##."},
+	'4': {"#.#", "#.#", "###", "..#", "..#"},
+	'5': {"###", "#..", "##.", "..#", "##."},
+	'6': {".##", "#..", "###", "#.#", "###"},
+	'7': {"###", "..#", ".#.", ".#.", ".#."},
+	'8': {"###", "#.#", "###", "#.#", "###"},
+	'9': {"###", "#.#", "###", "..#", "##."},
+	'_': {"...", "...", "...", "...", "###"},
+	'-': {"...", "...", "###", "...", "..."},
+	'.': {"...", "...", "...", "...", ".#."},
+	'+': {"...", ".#.", "###", ".#.", "..."},
+	';': {"...", ".#.", "...", ".#.", "#.."},
+	'/': {"..#", "..#", ".#.", "#..", "#.."},
+	'%': {"#.#", "..#", ".#.", "#..", "#.#"},
+	'(': {".#.", "#..", "#..", "#..", ".#."},
+	')': {".#.", "..#", "..#", "..#", ".#."},
+	':': {"...", ".#.", "...", ".#.", "..."},
+}
+
+func drawText(img *image.RGBA, x, y int, s string, c color.Color) {
+	for _, r := range strings.ToUpper(s) {
+		for row, bits := range glyphs[r] {
+			for col := 0; col < len(bits); col++ {
+				if bits[col] != '#' {
+					continue
+				}
+				px, py := x+col*legendScale, y+row*legendScale
+				draw.Draw(img, image.Rect(px, py, px+legendScale, py+legendScale), image.NewUniform(c), image.Point{}, draw.Src)
+			}
+		}
+		x += (glyphWidth + 1) * legendScale
+	}
+}
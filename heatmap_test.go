@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestPatternHeatmapOverlap(t *testing.T) {
+	records := []Record{
+		{ID: "A", InstrClass: "general", RegDiagram: "0000xxxxxxxxxxxxxxxxxxxxxxxxxxxx"},
+		{ID: "B", InstrClass: "sve", RegDiagram: "00000000xxxxxxxxxxxxxxxxxxxxxxxx"},
+	}
+	names, cats, err := categorize(records, "class")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := NewPatternHeatmap(records, cats, names, WordRange{}, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []uint64{1 << 28, 1 << 24, 15 << 28}
+	for c, n := range want {
+		if h.Counts[c] != n {
+			t.Errorf("%s: %d words, want %d", names[c], h.Counts[c], n)
+		}
+	}
+}
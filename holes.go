@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math/bits"
+	"strings"
+	"text/tabwriter"
+)
+
+// A holeSearch finds the maximal free cubes that fix at most limit bits. A
+// cube is free when it fixes, for every allocated cube, some bit that the
+// allocated cube fixes to the other value; it then hits that cube. The
+// maximal free cubes are the minimal sets of fixed bits that hit every
+// allocated cube, which are enumerated as in the MMCS algorithm for minimal
+// hitting sets: branch on the bits that hit the unhit cube with the fewest
+// choices, and drop a set as soon as one of its bits no longer hits a cube
+// on its own, as no larger set can then be minimal either.
+type holeSearch struct {
+	alloc []Cube
+	limit int
+	out   []Cube
+}
+
+// search extends c, whose bits hit every allocated cube except those in
+// unhit, and for each fixed bit i the cubes in crit[i] only on that bit. The
+// bits of cand0 and cand1 may still be fixed to 0 and 1.
+func (h *holeSearch) search(c Cube, cand0, cand1 uint32, unhit []int32, crit *[32][]int32) {
+	if len(unhit) == 0 {
+		h.out = append(h.out, c)
+		return
+	}
+	if bits.OnesCount32(c.Mask) == h.limit {
+		return
+	}
+	var a Cube
+	var choices uint32
+	for i, e := range unhit {
+		u := h.alloc[e]
+		ch := u.Mask & (cand1&^u.Value | cand0&u.Value)
+		if ch == 0 {
+			return
+		}
+		if i == 0 || bits.OnesCount32(ch) < bits.OnesCount32(choices) {
+			a, choices = u, ch
+		}
+	}
+	// Each branch may not use the choices of the branches after it, so that
+	// every set is found once.
+	cand0 &^= choices & a.Value
+	cand1 &^= choices &^ a.Value
+	for ch := choices; ch != 0; ch &= ch - 1 {
+		bit := ch & -ch
+		lit := Cube{bit, ^a.Value & bit}
+		var next [32][]int32
+		minimal := true
+		for m := c.Mask; m != 0; m &= m - 1 {
+			j := bits.TrailingZeros32(m)
+			for _, e := range crit[j] {
+				if h.alloc[e].Intersects(lit) {
+					next[j] = append(next[j], e)
+				}
+			}
+			if len(next[j]) == 0 {
+				minimal = false
+				break
+			}
+		}
+		if minimal {
+			var rest []int32
+			i := bits.TrailingZeros32(bit)
+			for _, e := range unhit {
+				if h.alloc[e].Intersects(lit) {
+					rest = append(rest, e)
+				} else {
+					next[i] = append(next[i], e)
+				}
+			}
+			h.search(Cube{c.Mask | bit, c.Value | lit.Value}, cand0&^bit, cand1&^bit, rest, &next)
+		}
+		if lit.Value == 0 {
+			cand0 |= bit
+		} else {
+			cand1 |= bit
+		}
+	}
+}
+
+// freeCubes returns the maximal cubes, fixing at most limit bits, that meet
+// none of the cubes in alloc.
+func freeCubes(alloc []Cube, limit int) []Cube {
+	h := &holeSearch{alloc: alloc, limit: limit}
+	unhit := make([]int32, len(alloc))
+	for i := range unhit {
+		unhit[i] = int32(i)
+	}
+	h.search(Universe, ^uint32(0), ^uint32(0), unhit, &[32][]int32{})
+	return h.out
+}
+
+// parseReserve parses a comma-separated list of diagrams or x-masks such as
+// 0000xxxxxxxxxxxxxxxxxxxxxxxxxxxx.
+func parseReserve(s string) ([]Pattern, error) {
+	var ps []Pattern
+	if s == "" {
+		return nil, nil
+	}
+	for _, r := range strings.Split(s, ",") {
+		p, err := ParseDiagram(strings.TrimSpace(r))
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, p)
+	}
+	return ps, nil
+}
+
+// Holes returns the largest maximal free cubes of the word space, those that
+// meet neither a record nor a reserved pattern, largest first. It returns at
+// least top cubes if there are that many.
+func Holes(records []Record, reserved []Pattern, top int) ([]Cube, error) {
+	ps, err := recordPatterns(records)
+	if err != nil {
+		return nil, err
+	}
+	// The allocated space only needs to be covered by cubes, not split into
+	// disjoint ones, and covering each pattern separately keeps the cubes few.
+	var alloc []Cube
+	for _, p := range append(ps, reserved...) {
+		alloc = append(alloc, PatternSet([]Pattern{p})...)
+	}
+	// Look for large cubes first, only allowing more fixed bits if there are
+	// not enough.
+	for limit := 0; ; limit++ {
+		holes := freeCubes(alloc, limit)
+		if top >= 0 && len(holes) >= top || limit == 32 {
+			sortLargest(holes)
+			return holes, nil
+		}
+	}
+}
+
+func writeHoles(w io.Writer, holes []Cube, top int) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for i, h := range holes {
+		if i == top {
+			break
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t\n", h, h.Size(), percent(h.Size()))
+	}
+	tw.Flush()
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/xml"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// TestFreeCubes checks the free cubes of words whose allocated cubes only fix
+// the low 12 bits against every cube that can be formed from those bits.
+func TestFreeCubes(t *testing.T) {
+	const bits = 12
+	r := rand.New(rand.NewSource(1))
+	for it := 0; it < 20; it++ {
+		var alloc []Cube
+		for i := 0; i < 1+r.Intn(8); i++ {
+			m := uint32(r.Intn(1<<bits) | r.Intn(1<<bits))
+			alloc = append(alloc, Cube{m, uint32(r.Intn(1<<bits)) & m})
+		}
+		var used [1 << bits]bool
+		for w := range used {
+			for _, c := range alloc {
+				if uint32(w)&c.Mask == c.Value {
+					used[w] = true
+				}
+			}
+		}
+		free := func(c Cube) bool {
+			x := ^c.Mask & (1<<bits - 1)
+			for s := x; ; s = (s - 1) & x {
+				if used[c.Value|s] {
+					return false
+				}
+				if s == 0 {
+					return true
+				}
+			}
+		}
+		var want []string
+		for m := uint32(0); m < 1<<bits; m++ {
+			for v := m; ; v = (v - 1) & m {
+				c := Cube{m, v}
+				if free(c) {
+					grown := false
+					for b := m; b != 0; b &= b - 1 {
+						bit := b & -b
+						if free(Cube{m &^ bit, v &^ bit}) {
+							grown = true
+							break
+						}
+					}
+					if !grown {
+						want = append(want, c.String())
+					}
+				}
+				if v == 0 {
+					break
+				}
+			}
+		}
+		var got []string
+		for _, c := range freeCubes(alloc, bits) {
+			got = append(got, c.String())
+		}
+		sort.Strings(want)
+		sort.Strings(got)
+		if len(got) != len(want) {
+			t.Fatalf("%d: got %d cubes, want %d", it, len(got), len(want))
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("%d: got %s, want %s", it, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestEncodingIndexReserved(t *testing.T) {
+	const src = `<encodingindex id="index"><hierarchy>
+<regdiagram form="32" psname=""><box hibit="31" name="op0" usename="1"><c>x</c></box><box hibit="30" width="2"><c colspan="2"></c></box><box hibit="28" width="4" name="op1" usename="1"><c colspan="4"></c></box><box hibit="24" width="25"><c colspan="25"></c></box></regdiagram>
+<node groupname="reserved"><decode><box name="op0"><c>0</c></box><box name="op1"><c>0000</c></box></decode></node>
+<node groupname="sme"><decode><box name="op0"><c>1</c></box><box name="op1"><c>0000</c></box></decode>
+  <regdiagram form="32" psname=""><box hibit="31" width="7"><c colspan="7"></c></box><box hibit="24" width="2" name="op2" usename="1"><c colspan="2"></c></box><box hibit="22" width="23"><c colspan="23"></c></box></regdiagram>
+  <node iclass="sme_undef" undefined="1"><decode><box name="op2"><c>!= 00</c></box></decode></node>
+</node>
+<node unallocated="1"><decode><box name="op1"><c>0001</c></box></decode></node>
+</hierarchy></encodingindex>`
+	var ix EncodingIndex
+	if err := xml.Unmarshal([]byte(src), &ix); err != nil {
+		t.Fatal(err)
+	}
+	ps, err := ix.Reserved()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"0xx0000xxxxxxxxxxxxxxxxxxxxxxxxx",
+		"1xx0000xxxxxxxxxxxxxxxxxxxxxxxxx != xxxxxxx00xxxxxxxxxxxxxxxxxxxxxxx",
+	}
+	if len(ps) != len(want) {
+		t.Fatalf("got %d reserved patterns, want %d", len(ps), len(want))
+	}
+	for i, p := range ps {
+		got := p.String()
+		for _, e := range p.Excludes {
+			got += " != " + Pattern{Mask: e.Mask, Value: e.Value}.String()
+		}
+		if got != want[i] {
+			t.Errorf("reserved %d is %s, want %s", i, got, want[i])
+		}
+	}
+}
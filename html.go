@@ -0,0 +1,470 @@
+package main
+
+import (
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"html"
+	"html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var htmlCmd = &command{
+	name:  "html",
+	args:  "[flags] SPECDIR OUTDIR",
+	short: "generate a static, searchable HTML site for the filtered instructions",
+	run:   runHTML,
+}
+
+const sharedFile = "shared_pseudocode.xml"
+
+// SharedPs is the shared pseudocode library, whose functions are the targets
+// of the links in instruction pseudocode.
+type SharedPs struct {
+	XMLName xml.Name `xml:"instructionsection"`
+	Ps      []struct {
+		Name   string `xml:"name,attr"`
+		Link   string `xml:"mylink,attr"`
+		PsText PsText `xml:"pstext"`
+	} `xml:"ps_section>ps"`
+}
+
+func loadSharedPs(dir string) (*SharedPs, error) {
+	shared := &SharedPs{}
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != sharedFile {
+			return err
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if err := xml.Unmarshal(data, shared); err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		return fs.SkipAll
+	})
+	return shared, err
+}
+
+// anchors returns the set of link targets defined by the shared pseudocode.
+func (s *SharedPs) anchors() map[string]bool {
+	set := make(map[string]bool)
+	for _, ps := range s.Ps {
+		if ps.Link != "" {
+			set[ps.Link] = true
+		}
+		dec := xml.NewDecoder(strings.NewReader("<x>" + ps.PsText.Content + "</x>"))
+		for {
+			tok, err := dec.Token()
+			if err != nil {
+				break
+			}
+			if se, ok := tok.(xml.StartElement); ok && se.Name.Local == "anchor" {
+				set[xmlAttr(se, "link")] = true
+			}
+		}
+	}
+	return set
+}
+
+func xmlAttr(se xml.StartElement, name string) string {
+	for _, a := range se.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// psHTML converts pseudocode markup to HTML. Links into the shared
+// pseudocode become links to shared.html, and anchors become link targets.
+func psHTML(code string, anchors map[string]bool, prefix string) template.HTML {
+	b := &strings.Builder{}
+	dec := xml.NewDecoder(strings.NewReader("<x>" + code + "</x>"))
+	var open []string
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			break
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			b.WriteString(html.EscapeString(string(t)))
+		case xml.StartElement:
+			link := xmlAttr(t, "link")
+			switch {
+			case t.Name.Local == "a" && anchors[link] && (xmlAttr(t, "file") == sharedFile || xmlAttr(t, "file") == ""):
+				fmt.Fprintf(b, `<a href="%sshared.html#%s"`, prefix, html.EscapeString(link))
+				if hover := xmlAttr(t, "hover"); hover != "" {
+					fmt.Fprintf(b, ` title="%s"`, html.EscapeString(hover))
+				}
+				b.WriteString(">")
+				open = append(open, "</a>")
+			case t.Name.Local == "anchor" && link != "":
+				fmt.Fprintf(b, `<a id="%s">`, html.EscapeString(link))
+				open = append(open, "</a>")
+			default:
+				open = append(open, "")
+			}
+		case xml.EndElement:
+			if len(open) > 0 {
+				b.WriteString(open[len(open)-1])
+				open = open[:len(open)-1]
+			}
+		}
+	}
+	return template.HTML(strings.TrimSpace(b.String()))
+}
+
+// A cell is a box of a bit diagram.
+type cell struct {
+	Name  string
+	Bits  string
+	Span  int
+	Color string
+}
+
+// fieldColor picks a stable background color for a named field.
+func fieldColor(name string) string {
+	if name == "" {
+		return "#e0e0e0"
+	}
+	h := fnv.New32a()
+	io.WriteString(h, name)
+	return fmt.Sprintf("hsl(%d, 70%%, 85%%)", h.Sum32()%360)
+}
+
+func diagramCells(boxes []Box) []cell {
+	var cells []cell
+	for _, b := range boxes {
+		bits := b.Pattern()
+		if b.Constraint != "" {
+			bits = strings.ReplaceAll(b.Constraint, " ", "")
+		}
+		cells = append(cells, cell{Name: b.Name, Bits: bits, Span: b.Size(), Color: fieldColor(b.Name)})
+	}
+	return cells
+}
+
+type htmlEncoding struct {
+	Name  string
+	Label string
+	Asm   string
+	Bits  string
+}
+
+type htmlClass struct {
+	Id        string
+	Name      string
+	Group     string
+	Variants  string
+	Cells     []cell
+	Encodings []htmlEncoding
+	Decode    template.HTML
+}
+
+type htmlInsn struct {
+	File       string
+	Page       string
+	Title      string
+	Type       string
+	Classes    []string
+	Features   []string
+	MinVersion string
+	Effects    []string
+	IClasses   []htmlClass
+	Operation  template.HTML
+}
+
+// encodingGroup is the encoding group an iclass belongs to: the directory of
+// its pseudocode name, e.g. aarch64/integer/arithmetic/add-sub/immediate.
+func encodingGroup(c IClass) string {
+	return path.Dir(c.RegDiagram.Name)
+}
+
+func newHTMLInsn(f SpecFile, model *FeatureModel, anchors map[string]bool) htmlInsn {
+	insn := f.Insn
+	page := strings.TrimSuffix(f.Name, ".xml") + ".html"
+	h := htmlInsn{
+		File:       f.Name,
+		Page:       page,
+		Title:      strings.Join(insn.Names(), ", "),
+		Type:       insn.Type,
+		Classes:    insn.GetClasses(),
+		Features:   insn.Features(),
+		MinVersion: model.MinVersionOf(insn).String(),
+		Effects:    insn.Effects(),
+		Operation:  psHTML(insn.Code.Ps.PsText.Content, anchors, "../"),
+	}
+	for _, c := range insn.Classes.IClass {
+		hc := htmlClass{
+			Id:       c.Id,
+			Name:     c.Name,
+			Group:    encodingGroup(c),
+			Variants: variantString(c.ArchVariants),
+			Cells:    diagramCells(c.RegDiagram.Boxes),
+			Decode:   psHTML(c.Code.Ps.PsText.Content, anchors, "../"),
+		}
+		for i := range c.Encodings {
+			e := &c.Encodings[i]
+			hc.Encodings = append(hc.Encodings, htmlEncoding{
+				Name:  e.Name,
+				Label: e.Label,
+				Asm:   e.Asm(),
+				Bits:  c.Bits(e),
+			})
+		}
+		h.IClasses = append(h.IClasses, hc)
+	}
+	return h
+}
+
+type htmlGroup struct {
+	Key   string
+	Insns []htmlInsn
+}
+
+type htmlIndex struct {
+	Title  string
+	Groups []htmlGroup
+}
+
+// groupBy groups the instructions by each of the keys returned by keys.
+func groupBy(insns []htmlInsn, keys func(h htmlInsn) []string) []htmlGroup {
+	m := make(map[string][]htmlInsn)
+	for _, h := range insns {
+		seen := make(map[string]bool)
+		for _, k := range keys(h) {
+			if !seen[k] {
+				seen[k] = true
+				m[k] = append(m[k], h)
+			}
+		}
+	}
+	var groups []htmlGroup
+	for k, v := range m {
+		groups = append(groups, htmlGroup{Key: k, Insns: v})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Key < groups[j].Key
+	})
+	return groups
+}
+
+const htmlStyle = `body { font-family: sans-serif; margin: 2em; }
+nav a { margin-right: 1em; }
+table { border-collapse: collapse; }
+td, th { padding: 2px 6px; text-align: left; }
+table.diagram td { border: 1px solid #888; text-align: center; font-family: monospace; }
+table.diagram tr.bitnum td { border: none; font-size: 70%; color: #666; }
+table.list td { border-bottom: 1px solid #ddd; }
+pre { background: #f6f6f6; padding: 1em; overflow-x: auto; }
+code { white-space: pre; }
+`
+
+const htmlHead = `{{define "head"}}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.}}</title>
+<style>` + htmlStyle + `</style>
+</head>
+<body>
+{{end}}
+{{define "nav"}}<nav><a href="{{.}}index.html">Instructions</a><a href="{{.}}class.html">By class</a><a href="{{.}}feature.html">By feature</a><a href="{{.}}group.html">By encoding group</a><a href="{{.}}shared.html">Shared pseudocode</a></nav>
+{{end}}
+{{define "diagram"}}<table class="diagram">
+<tr class="bitnum">{{range bitnums}}<td>{{.}}</td>{{end}}</tr>
+<tr>{{range .}}<td colspan="{{.Span}}" style="background: {{.Color | css}}">{{.Bits}}</td>{{end}}</tr>
+<tr>{{range .}}<td colspan="{{.Span}}" style="background: {{.Color | css}}">{{.Name}}</td>{{end}}</tr>
+</table>
+{{end}}`
+
+var htmlTemplates = template.Must(template.New("").Funcs(template.FuncMap{
+	"bitnums": func() []int {
+		n := make([]int, 32)
+		for i := range n {
+			n[i] = 31 - i
+		}
+		return n
+	},
+	"join": strings.Join,
+	"id": func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if r == '/' || r == ' ' {
+				return '-'
+			}
+			return r
+		}, s)
+	},
+	"css": func(s string) template.CSS { return template.CSS(s) },
+}).Parse(htmlHead + `
+{{define "insn"}}{{template "head" .Title}}{{template "nav" "../"}}
+<h1>{{.Title}}</h1>
+<table>
+<tr><th>File</th><td>{{.File}}</td></tr>
+<tr><th>Type</th><td>{{.Type}}</td></tr>
+<tr><th>Class</th><td>{{range .Classes}}<a href="../class.html#{{.}}">{{.}}</a> {{end}}</td></tr>
+<tr><th>Features</th><td>{{range .Features}}<a href="../feature.html#{{.}}">{{.}}</a> {{else}}<a href="../feature.html#base">base</a>{{end}}</td></tr>
+<tr><th>Minimum version</th><td>{{.MinVersion}}</td></tr>
+{{if .Effects}}<tr><th>Effects</th><td>{{join .Effects ", "}}</td></tr>{{end}}
+</table>
+{{range .IClasses}}
+<h2 id="{{.Id}}">{{.Name}} <small>({{.Id}})</small></h2>
+<p>Encoding group: <a href="../group.html#{{id .Group}}">{{.Group}}</a>{{if .Variants}}<br>Variants: {{.Variants}}{{end}}</p>
+{{template "diagram" .Cells}}
+<table class="list">
+<tr><th>Encoding</th><th>Bits</th><th>Assembly</th></tr>
+{{range .Encodings}}<tr id="{{.Name}}"><td>{{.Name}}{{if .Label}} <small>({{.Label}})</small>{{end}}</td><td><code>{{.Bits}}</code></td><td><code>{{.Asm}}</code></td></tr>
+{{end}}</table>
+{{if .Decode}}<h3>Decode</h3>
+<pre>{{.Decode}}</pre>{{end}}
+{{end}}
+{{if .Operation}}<h2>Operation</h2>
+<pre>{{.Operation}}</pre>{{end}}
+</body>
+</html>
+{{end}}
+{{define "index"}}{{template "head" "Instructions"}}{{template "nav" ""}}
+<h1>Instructions</h1>
+<p><input id="search" type="search" placeholder="Search by mnemonic, file, class or feature" size="50" autofocus></p>
+<table class="list" id="insns">
+<tr><th>Instruction</th><th>File</th><th>Class</th><th>Features</th><th>Minimum version</th></tr>
+{{range .}}<tr data-search="{{.Title}} {{.File}} {{join .Classes " "}} {{join .Features " "}}"><td><a href="insn/{{.Page}}">{{.Title}}</a></td><td>{{.File}}</td><td>{{join .Classes ", "}}</td><td>{{join .Features ", "}}</td><td>{{.MinVersion}}</td></tr>
+{{end}}</table>
+<script>
+document.getElementById("search").addEventListener("input", function() {
+	var words = this.value.toLowerCase().split(/\s+/).filter(function(w) { return w; });
+	document.querySelectorAll("#insns tr[data-search]").forEach(function(row) {
+		var text = row.dataset.search.toLowerCase();
+		row.style.display = words.every(function(w) { return text.indexOf(w) >= 0; }) ? "" : "none";
+	});
+});
+</script>
+</body>
+</html>
+{{end}}
+{{define "groups"}}{{template "head" .Title}}{{template "nav" ""}}
+<h1>{{.Title}}</h1>
+<ul>{{range .Groups}}<li><a href="#{{id .Key}}">{{.Key}}</a> ({{len .Insns}})</li>{{end}}</ul>
+{{range .Groups}}
+<h2 id="{{id .Key}}">{{.Key}}</h2>
+<ul>{{range .Insns}}<li><a href="insn/{{.Page}}">{{.Title}}</a> <small>{{.File}}</small></li>{{end}}</ul>
+{{end}}
+</body>
+</html>
+{{end}}
+{{define "shared"}}{{template "head" "Shared pseudocode"}}{{template "nav" ""}}
+<h1>Shared pseudocode</h1>
+{{range .}}
+<h2{{if .Link}} id="{{.Link}}"{{end}}>{{.Name}}</h2>
+<pre>{{.Code}}</pre>
+{{end}}
+</body>
+</html>
+{{end}}`))
+
+func writeTemplate(path, name string, data interface{}) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := htmlTemplates.ExecuteTemplate(f, name, data); err != nil {
+		f.Close()
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return f.Close()
+}
+
+func runHTML(fs *flag.FlagSet, args []string) error {
+	filters := addFilterFlags(fs, baseFilters)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return usagef("expected a spec directory and an output directory")
+	}
+	dir, out := fs.Arg(0), fs.Arg(1)
+	files, model, err := loadFiltered(dir, filters)
+	if err != nil {
+		return err
+	}
+	shared, err := loadSharedPs(dir)
+	if err != nil {
+		return err
+	}
+	anchors := shared.anchors()
+
+	if err := os.MkdirAll(filepath.Join(out, "insn"), 0o755); err != nil {
+		return err
+	}
+	var insns []htmlInsn
+	for _, f := range files {
+		h := newHTMLInsn(f, model, anchors)
+		if err := writeTemplate(filepath.Join(out, "insn", h.Page), "insn", h); err != nil {
+			return err
+		}
+		insns = append(insns, h)
+	}
+	sort.SliceStable(insns, func(i, j int) bool {
+		return insns[i].Title < insns[j].Title
+	})
+	if err := writeTemplate(filepath.Join(out, "index.html"), "index", insns); err != nil {
+		return err
+	}
+
+	indexes := []struct {
+		page string
+		idx  htmlIndex
+	}{
+		{"class.html", htmlIndex{Title: "Instructions by class", Groups: groupBy(insns, func(h htmlInsn) []string {
+			return h.Classes
+		})}},
+		{"feature.html", htmlIndex{Title: "Instructions by feature", Groups: groupBy(insns, func(h htmlInsn) []string {
+			if len(h.Features) == 0 {
+				return []string{"base"}
+			}
+			return h.Features
+		})}},
+		{"group.html", htmlIndex{Title: "Instructions by encoding group", Groups: groupBy(insns, func(h htmlInsn) []string {
+			var groups []string
+			for _, c := range h.IClasses {
+				groups = append(groups, c.Group)
+			}
+			return groups
+		})}},
+	}
+	for _, x := range indexes {
+		if err := writeTemplate(filepath.Join(out, x.page), "groups", x.idx); err != nil {
+			return err
+		}
+	}
+
+	type sharedPs struct {
+		Name string
+		Link string
+		Code template.HTML
+	}
+	var ps []sharedPs
+	for _, p := range shared.Ps {
+		link := p.Link
+		if strings.Contains(p.PsText.Content, `<anchor link="`+link+`"`) {
+			// The anchor in the code is already the link target.
+			link = ""
+		}
+		ps = append(ps, sharedPs{Name: p.Name, Link: link, Code: psHTML(p.PsText.Content, anchors, "")})
+	}
+	if err := writeTemplate(filepath.Join(out, "shared.html"), "shared", ps); err != nil {
+		return err
+	}
+	fmt.Printf("%s: %d instructions\n", out, len(insns))
+	return nil
+}
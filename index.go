@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const indexFile = "encodingindex.xml"
+
+// An EncodingIndex is the A64 decode tree from encodingindex.xml. Each node
+// selects part of its parent's space by the values of fields named in its
+// decode boxes, which are laid out by the nearest regdiagram above it.
+type EncodingIndex struct {
+	XMLName   xml.Name `xml:"encodingindex"`
+	Hierarchy struct {
+		RegDiagram RegDiagram  `xml:"regdiagram"`
+		Nodes      []IndexNode `xml:"node"`
+	} `xml:"hierarchy"`
+}
+
+type IndexNode struct {
+	GroupName  string      `xml:"groupname,attr"`
+	IClass     string      `xml:"iclass,attr"`
+	Attrs      []xml.Attr  `xml:",any,attr"`
+	Decode     []Box       `xml:"decode>box"`
+	RegDiagram *RegDiagram `xml:"regdiagram"`
+	Nodes      []IndexNode `xml:"node"`
+}
+
+// reserved reports whether the node is space that must not be allocated: a
+// group named reserved, or space marked UNDEFINED. UNALLOCATED space is free.
+func (n *IndexNode) reserved() bool {
+	if strings.EqualFold(n.GroupName, "reserved") || strings.EqualFold(n.IClass, "reserved") {
+		return true
+	}
+	for _, a := range n.Attrs {
+		switch strings.ToLower(a.Name.Local) {
+		case "reserved", "undef", "undefined":
+			if a.Value == "1" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// decodeBits returns the value of a decode box as a string of 0, 1 and x, and
+// whether it is a "!=" constraint.
+func decodeBits(b Box) (string, bool) {
+	var v string
+	for _, c := range b.Bits {
+		if t := strings.TrimSpace(c.Value); t != "" {
+			v += t
+		} else if c.Cols > 1 {
+			v += strings.Repeat("x", c.Cols)
+		} else {
+			v += "x"
+		}
+	}
+	v = strings.ReplaceAll(v, " ", "")
+	if strings.HasPrefix(v, "!=") {
+		return strings.TrimPrefix(v, "!="), true
+	}
+	return v, false
+}
+
+// fieldLayout maps field names to their boxes in a regdiagram.
+type fieldLayout map[string]Box
+
+func (l fieldLayout) with(rd *RegDiagram) fieldLayout {
+	if rd == nil {
+		return l
+	}
+	out := make(fieldLayout, len(l)+len(rd.Boxes))
+	for k, v := range l {
+		out[k] = v
+	}
+	for _, b := range rd.Boxes {
+		if b.Name != "" {
+			out[b.Name] = b
+		}
+	}
+	return out
+}
+
+// nodePattern narrows p to the space the node's decode boxes select.
+func nodePattern(p Pattern, layout fieldLayout, n *IndexNode) (Pattern, error) {
+	p.Excludes = append([]Exclude(nil), p.Excludes...)
+	for _, d := range n.Decode {
+		f, ok := layout[d.Name]
+		if !ok {
+			return p, fmt.Errorf("node %q decodes unknown field %q", n.GroupName+n.IClass, d.Name)
+		}
+		bits, not := decodeBits(d)
+		if len(bits) != f.Size() {
+			return p, fmt.Errorf("node %q: field %s is %d bits, not %q", n.GroupName+n.IClass, d.Name, f.Size(), bits)
+		}
+		mask, value := ParseBits(bits, f.HiBit-f.Size()+1)
+		if not {
+			p.Excludes = append(p.Excludes, Exclude{mask, value})
+		} else {
+			p.Mask |= mask
+			p.Value |= value
+		}
+	}
+	return p, nil
+}
+
+// Reserved returns the patterns of the reserved and UNDEFINED space in the
+// decode tree.
+func (ix *EncodingIndex) Reserved() ([]Pattern, error) {
+	var out []Pattern
+	var walk func(nodes []IndexNode, p Pattern, layout fieldLayout) error
+	walk = func(nodes []IndexNode, p Pattern, layout fieldLayout) error {
+		for i := range nodes {
+			n := &nodes[i]
+			np, err := nodePattern(p, layout, n)
+			if err != nil {
+				return err
+			}
+			if n.reserved() {
+				out = append(out, np)
+				continue
+			}
+			if err := walk(n.Nodes, np, layout.with(n.RegDiagram)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	h := &ix.Hierarchy
+	err := walk(h.Nodes, Pattern{}, fieldLayout{}.with(&h.RegDiagram))
+	return out, err
+}
+
+// LoadReserved reads the reserved space from the encodingindex.xml at path,
+// or in the directory path.
+func LoadReserved(path string) ([]Pattern, error) {
+	if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+		path = filepath.Join(path, indexFile)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var ix EncodingIndex
+	if err := xml.Unmarshal(data, &ix); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	ps, err := ix.Reserved()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return ps, nil
+}
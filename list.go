@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var listCmd = &command{
+	name:  "list",
+	args:  "[flags] SPECDIR",
+	short: "list instructions matching the filters",
+	run: func(fs *flag.FlagSet, args []string) error {
+		return runList(fs, args, false)
+	},
+}
+
+var encodeCmd = &command{
+	name:  "encode",
+	args:  "[flags] SPECDIR",
+	short: "list instructions matching the filters along with their encodings",
+	run: func(fs *flag.FlagSet, args []string) error {
+		return runList(fs, args, true)
+	},
+}
+
+var genCmd = &command{
+	name:  "gen",
+	args:  "-func NAME [flags] SPECDIR",
+	short: "generate a Rust function matching the filtered instructions",
+	run:   runGen,
+}
+
+func writeJSON(v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stdout, string(b))
+	return err
+}
+
+func runList(fs *flag.FlagSet, args []string, encoding bool) error {
+	filters := addFilterFlags(fs, baseFilters)
+	defcols := "file,name,class"
+	if encoding {
+		defcols = "file,iclass,diagram"
+	}
+	format := fs.String("format", "text", "output `format`: "+strings.Join(formats, ", "))
+	cols := fs.String("columns", defcols, "comma-separated `list` of columns: "+columnNames())
+	sortBy := fs.String("sort", "file", "comma-separated `list` of columns to sort by")
+	jsonOut := fs.Bool("json", false, "write every column as JSON records; short for -format json -columns "+columnNames())
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *jsonOut {
+		var set []string
+		fs.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "format", "columns", "sort":
+				set = append(set, "-"+f.Name)
+			}
+		})
+		if len(set) != 0 {
+			return usagef("-json cannot be combined with %s", strings.Join(set, ", "))
+		}
+		*format = "json"
+		*cols = columnNames()
+		*sortBy = "id"
+	}
+	if !validFormat(*format) {
+		return usagef("unknown format %q", *format)
+	}
+	selected, err := parseColumns(*cols)
+	if err != nil {
+		return usagef("%v", err)
+	}
+	by, err := parseColumns(*sortBy)
+	if err != nil {
+		return usagef("%v", err)
+	}
+	dir, err := specArg(fs)
+	if err != nil {
+		return err
+	}
+	files, model, err := loadFiltered(dir, filters)
+	if err != nil {
+		return err
+	}
+
+	var records []Record
+	for _, f := range files {
+		records = append(records, NewRecords(f.Name, f.Insn, model)...)
+	}
+	sortRecords(records, by)
+	w := bufio.NewWriter(os.Stdout)
+	if err := WriteRecords(w, *format, records, selected); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func runGen(fs *flag.FlagSet, args []string) error {
+	filters := addFilterFlags(fs, baseFilters)
+	rust := fs.String("func", "", "name of the generated Rust function")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *rust == "" {
+		return usagef("-func is required")
+	}
+	dir, err := specArg(fs)
+	if err != nil {
+		return err
+	}
+	files, _, err := loadFiltered(dir, filters)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print("// AUTO-GENERATED FILE: DO NOT EDIT\n")
+	fmt.Printf("pub fn %s(op: Op) -> bool {\n", *rust)
+	fmt.Printf("\tmatch op {\n")
+	names := make(map[string]bool)
+	for _, f := range files {
+		if f.Name == "b_cond.xml" {
+			fmt.Print(condbranches)
+			continue
+		}
+		for _, n := range f.Insn.Names() {
+			if !names[n] {
+				fmt.Printf("\t\tOp::%s => true,\n", n)
+				names[n] = true
+			}
+		}
+	}
+	fmt.Printf("\t\t_ => false,\n")
+	fmt.Printf("\t}\n}\n\n")
+	return nil
+}
+
+var condbranches = `		Op::B_AL => true,
+		Op::B_CC => true,
+		Op::B_CS => true,
+		Op::B_EQ => true,
+		Op::B_GE => true,
+		Op::B_GT => true,
+		Op::B_HI => true,
+		Op::B_LE => true,
+		Op::B_LS => true,
+		Op::B_LT => true,
+		Op::B_MI => true,
+		Op::B_NE => true,
+		Op::B_NV => true,
+		Op::B_PL => true,
+		Op::B_VC => true,
+		Op::B_VS => true,
+`
@@ -2,15 +2,15 @@ package main
 
 import (
 	"bytes"
-	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"flag"
 	"fmt"
-	"io/fs"
+	"html"
 	"log"
 	"os"
-	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -125,6 +125,36 @@ type Box struct {
 	Bits       []BitC   `xml:"c"`
 	Name       string   `xml:"name,attr"`
 	Constraint string   `xml:"constraint,attr"`
+	HiBit      int      `xml:"hibit,attr"`
+	Width      int      `xml:"width,attr"`
+}
+
+func (b Box) Size() int {
+	if b.Width == 0 {
+		return 1
+	}
+	return b.Width
+}
+
+// Pattern returns the box's bits as a string of 0, 1 and x, from the high bit
+// down. Constraint boxes have no fixed bits and return all x.
+func (b Box) Pattern() string {
+	if b.Constraint != "" {
+		return strings.Repeat("x", b.Size())
+	}
+	buf := &bytes.Buffer{}
+	for _, bit := range b.Bits {
+		cols := bit.Cols
+		if cols == 0 {
+			cols = 1
+		}
+		v := strings.Trim(bit.Value, "()")
+		if v != "0" && v != "1" {
+			v = "x"
+		}
+		buf.WriteString(strings.Repeat(v, cols))
+	}
+	return buf.String()
 }
 
 type RegDiagram struct {
@@ -163,10 +193,22 @@ func (r RegDiagram) String() string {
 	return b.String()
 }
 
+type AsmTemplate struct {
+	XMLName xml.Name `xml:"asmtemplate"`
+	Content string   `xml:",innerxml"`
+}
+
 type Encoding struct {
-	XMLName xml.Name `xml:"encoding"`
-	Name    string   `xml:"name,attr"`
-	Docs    DocVars  `xml:"docvars"`
+	XMLName     xml.Name    `xml:"encoding"`
+	Name        string      `xml:"name,attr"`
+	Label       string      `xml:"label,attr"`
+	Docs        DocVars     `xml:"docvars"`
+	Boxes       []Box       `xml:"box"`
+	AsmTemplate AsmTemplate `xml:"asmtemplate"`
+}
+
+func (e Encoding) Asm() string {
+	return stripMarkup(e.AsmTemplate.Content)
 }
 
 type IClass struct {
@@ -180,6 +222,43 @@ type IClass struct {
 	Docs         DocVars      `xml:"docvars"`
 }
 
+// Bits returns the 32-bit pattern of 0, 1 and x for an encoding of the
+// iclass, from bit 31 down, with the encoding's own boxes applied over the
+// iclass diagram.
+func (ic IClass) Bits(e *Encoding) string {
+	bits := []byte(strings.Repeat("x", 32))
+	apply := func(boxes []Box) {
+		for _, b := range boxes {
+			p := b.Pattern()
+			for i := 0; i < len(p) && b.HiBit-i >= 0; i++ {
+				if p[i] != 'x' {
+					bits[31-(b.HiBit-i)] = p[i]
+				}
+			}
+		}
+	}
+	apply(ic.RegDiagram.Boxes)
+	if e != nil {
+		apply(e.Boxes)
+	}
+	return string(bits)
+}
+
+// EncodingBoxes returns the iclass diagram's boxes with those redefined by
+// the encoding replaced.
+func (ic IClass) EncodingBoxes(e *Encoding) []Box {
+	boxes := make([]Box, len(ic.RegDiagram.Boxes))
+	copy(boxes, ic.RegDiagram.Boxes)
+	for _, eb := range e.Boxes {
+		for i, b := range boxes {
+			if b.HiBit == eb.HiBit && b.Name == eb.Name {
+				boxes[i] = eb
+			}
+		}
+	}
+	return boxes
+}
+
 func (ic IClass) BaseVariant() bool {
 	return len(ic.ArchVariants.Variants) == 0
 }
@@ -221,6 +300,12 @@ func getAssigns(code string) []Assign {
 
 var linkrx = regexp.MustCompile(`<a.*?>`)
 
+var tagrx = regexp.MustCompile(`<[^>]*>`)
+
+func stripMarkup(s string) string {
+	return html.UnescapeString(tagrx.ReplaceAllLiteralString(s, ""))
+}
+
 func (is InsnSection) ReadSet() []string {
 	lines := strings.Split(is.Code.Ps.PsText.Content, "\n")
 	var set []string
@@ -268,6 +353,28 @@ func (is InsnSection) IsBranch() bool {
 	return is.Uses("impl-shared.BranchTo.3")
 }
 
+// Effects returns the names of the effect predicates that hold for the
+// instruction.
+func (is InsnSection) Effects() []string {
+	var effects []string
+	if is.IsBranch() {
+		effects = append(effects, "branch")
+	}
+	if is.ReadsMem() {
+		effects = append(effects, "reads_mem")
+	}
+	if is.WritesMem() {
+		effects = append(effects, "writes_mem")
+	}
+	if is.MemAtomic() {
+		effects = append(effects, "atomic")
+	}
+	if is.UsesPc() {
+		effects = append(effects, "uses_pc")
+	}
+	return effects
+}
+
 func (is InsnSection) BaseVariant() bool {
 	for _, c := range is.Classes.IClass {
 		if !c.BaseVariant() {
@@ -288,20 +395,6 @@ func (is InsnSection) Variant(version string) bool {
 	return false
 }
 
-func (is InsnSection) VariantLE(version string) bool {
-	for _, c := range is.Classes.IClass {
-		if len(c.ArchVariants.Variants) == 0 {
-			return true
-		}
-		for _, v := range c.ArchVariants.Variants {
-			if strings.Compare(v.Name, version) <= 0 {
-				return true
-			}
-		}
-	}
-	return false
-}
-
 func (is InsnSection) HasClass(class string) bool {
 	if class == "all" {
 		return true
@@ -331,9 +424,42 @@ func (is InsnSection) GetClasses() []string {
 	for k := range m {
 		classes = append(classes, k)
 	}
+	sort.Strings(classes)
 	return classes
 }
 
+func (is InsnSection) Features() []string {
+	var feats []string
+	for _, c := range is.Classes.IClass {
+		for _, f := range c.ArchVariants.GetFeatures() {
+			if f != "" {
+				feats = append(feats, f)
+			}
+		}
+	}
+	return feats
+}
+
+func (is InsnSection) Variants() []string {
+	var vars []string
+	for _, c := range is.Classes.IClass {
+		for _, v := range c.ArchVariants.GetVariants() {
+			if v != "" {
+				vars = append(vars, v)
+			}
+		}
+	}
+	return vars
+}
+
+func (is InsnSection) IClassIds() []string {
+	var ids []string
+	for _, c := range is.Classes.IClass {
+		ids = append(ids, c.Id)
+	}
+	return ids
+}
+
 func (is InsnSection) BaseArch() bool {
 	if !strings.Contains(InstrBase, is.Docs.InstrClass()) {
 		return false
@@ -370,6 +496,7 @@ func (is InsnSection) WriteSet() []string {
 	for k := range set {
 		ret = append(ret, k)
 	}
+	sort.Strings(ret)
 	return ret
 }
 
@@ -388,10 +515,12 @@ func (is InsnSection) Names() []string {
 	for k := range set {
 		names = append(names, k)
 	}
+	sort.Strings(names)
 	return names
 }
 
 type Record struct {
+	ID         string
 	File       string
 	Name       string
 	IClass     string
@@ -401,9 +530,25 @@ type Record struct {
 	InstrClass string
 	RegDiagram string
 	Base       bool
+	MinVersion string
+	Effects    string
+}
+
+// RecordID returns a persistent identifier for an iclass, made from the
+// names of its encodings, which are unique across the spec.
+func RecordID(file string, c IClass) string {
+	var encs []string
+	for _, e := range c.Encodings {
+		encs = append(encs, e.Name)
+	}
+	if len(encs) == 0 {
+		return strings.TrimSuffix(file, ".xml") + "/" + c.Id
+	}
+	sort.Strings(encs)
+	return strings.Join(encs, ";")
 }
 
-func NewRecords(file string, insn InsnSection) []Record {
+func NewRecords(file string, insn InsnSection, model *FeatureModel) []Record {
 	var records []Record
 	for _, c := range insn.Classes.IClass {
 		set := make(map[string]bool)
@@ -414,7 +559,9 @@ func NewRecords(file string, insn InsnSection) []Record {
 		for k := range set {
 			names = append(names, k)
 		}
+		sort.Strings(names)
 		records = append(records, Record{
+			ID:         RecordID(file, c),
 			File:       file,
 			Name:       strings.Join(names, ";"),
 			IClass:     c.Id,
@@ -424,144 +571,119 @@ func NewRecords(file string, insn InsnSection) []Record {
 			InstrClass: c.Docs.InstrClass(),
 			RegDiagram: c.RegDiagram.String(),
 			Base:       c.BaseVariant(),
+			MinVersion: model.MinVersion(c).String(),
+			Effects:    strings.Join(insn.Effects(), ";"),
 		})
 	}
 	return records
 }
 
-func main() {
-	base := flag.Bool("base", true, "only consider instructions from the ARMv8.0 instruction set")
-	classes := flag.String("classes", "general,float,fpsimd,advsimd", "comma-separated list of instruction classes")
-	branch := flag.Bool("branch", false, "only show branch instructions")
-	rdmem := flag.Bool("rdmem", false, "only show instructions that read from memory")
-	wrmem := flag.Bool("wrmem", false, "only show instructions that write to memory")
-	atomic := flag.Bool("atomic", false, "only show atomic instructions")
-	nomodify := flag.Bool("nomodify", false, "only show instructions that do not modify any general-purpose registers")
-	encoding := flag.Bool("encoding", false, "show instruction encodings")
-	rust := flag.String("func", "", "generate rust function with name")
-	variant := flag.String("variant", "", "ISA version")
-	jsonOut := flag.Bool("json", false, "write output as JSON")
-
-	total := 0
-
-	flag.Parse()
-	args := flag.Args()
-
-	if *rust != "" {
-		fmt.Print("// AUTO-GENERATED FILE: DO NOT EDIT\n")
-		fmt.Printf("pub fn %s(op: Op) -> bool {\n", *rust)
-		fmt.Printf("\tmatch op {\n")
-	}
-
-	names := make(map[string]bool)
-	var allrecords []Record
-
-	filepath.WalkDir(args[0], func(path string, insn fs.DirEntry, err error) error {
-		if insn != nil && !insn.IsDir() && strings.HasSuffix(path, ".xml") {
-			data, err := os.ReadFile(path)
-			if err != nil {
-				log.Fatal(err)
-			}
-			var insn InsnSection
-			if err := xml.Unmarshal(data, &insn); err != nil {
-				return nil
-			}
+type command struct {
+	name  string
+	args  string
+	short string
+	run   func(fs *flag.FlagSet, args []string) error
+}
 
-			if insn.Type == "instruction" || insn.Type == "alias" {
-				if *base && !insn.BaseVariant() {
-					return nil
-				}
-				hasclass := false
-				for _, class := range strings.Split(*classes, ",") {
-					if insn.HasClass(class) {
-						hasclass = true
-					}
-				}
-				if !hasclass {
-					return nil
-				}
-				if *branch && !insn.IsBranch() {
-					return nil
-				}
-				if *rdmem && !insn.ReadsMem() {
-					return nil
-				}
-				if *wrmem && !insn.WritesMem() {
-					return nil
-				}
-				if *atomic && !insn.MemAtomic() {
-					return nil
-				}
-				if *nomodify && len(insn.WriteSet()) != 0 {
-					return nil
-				}
-				if *variant != "" && !insn.VariantLE(*variant) {
-					return nil
-				}
+var commands = []*command{
+	listCmd,
+	encodeCmd,
+	genCmd,
+	showCmd,
+	decodeCmd,
+	elfCmd,
+	requireCmd,
+	diffCmd,
+	exportCmd,
+	indexCmd,
+	htmlCmd,
+	classifyCmd,
+}
 
-				if *jsonOut {
-					allrecords = append(allrecords, NewRecords(filepath.Base(path), insn)...)
-					return nil
-				}
+// A usageError is reported together with the command's usage message.
+type usageError struct {
+	msg string
+}
 
-				if *rust != "" {
-					if filepath.Base(path) == "b_cond.xml" {
-						fmt.Print(condbranches)
-					} else {
-						for _, n := range insn.Names() {
-							if !names[n] {
-								fmt.Printf("\t\tOp::%s => true,\n", n)
-								names[n] = true
-							}
-						}
-					}
-				} else {
-					fmt.Printf("%s: %s (%s)\n", filepath.Base(path), insn.Names(), strings.Join(insn.GetClasses(), ";"))
-				}
+func (e usageError) Error() string {
+	return e.msg
+}
 
-				total += len(insn.Names())
+func usagef(format string, args ...interface{}) error {
+	return usageError{fmt.Sprintf(format, args...)}
+}
 
-				if *encoding {
-					for _, c := range insn.Classes.IClass {
-						fmt.Printf("\t%s: %s\n", c.Id, c.RegDiagram)
-					}
-				}
-			}
+func (c *command) flagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+	fs.Usage = func() {
+		out := fs.Output()
+		fmt.Fprintf(out, "usage: armgen %s %s\n\n%s.\n", c.name, c.args, c.short)
+		hasFlags := false
+		fs.VisitAll(func(*flag.Flag) { hasFlags = true })
+		if hasFlags {
+			fmt.Fprintf(out, "\nflags:\n")
+			fs.PrintDefaults()
 		}
-		return nil
-	})
+	}
+	return fs
+}
 
-	if *jsonOut {
-		b, err := json.MarshalIndent(allrecords, "", "    ")
-		if err != nil {
-			log.Fatal(err)
-		}
-		fmt.Println(string(b))
-		return
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: armgen <command> [flags] [args]\n\ncommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "\t%-10s %s\n", c.name, c.short)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun 'armgen <command> -h' for the flags of a command.\n")
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("armgen: ")
+
+	args := os.Args[1:]
+	if len(args) == 0 {
+		usage()
+		os.Exit(2)
 	}
 
-	if *rust != "" {
-		fmt.Printf("\t\t_ => false,\n")
-		fmt.Printf("\t}\n}\n\n")
+	name := args[0]
+	if name == "help" || name == "-h" || name == "-help" || name == "--help" {
+		usage()
+		return
+	}
+	// For compatibility, flags without a command select "list".
+	if strings.HasPrefix(name, "-") {
+		name = "list"
 	} else {
-		fmt.Printf("total instructions: %d\n", total)
-	}
-}
-
-var condbranches = `		Op::B_AL => true,
-		Op::B_CC => true,
-		Op::B_CS => true,
-		Op::B_EQ => true,
-		Op::B_GE => true,
-		Op::B_GT => true,
-		Op::B_HI => true,
-		Op::B_LE => true,
-		Op::B_LS => true,
-		Op::B_LT => true,
-		Op::B_MI => true,
-		Op::B_NE => true,
-		Op::B_NV => true,
-		Op::B_PL => true,
-		Op::B_VC => true,
-		Op::B_VS => true,
-`
+		args = args[1:]
+	}
+
+	var cmd *command
+	for _, c := range commands {
+		if c.name == name {
+			cmd = c
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(os.Stderr, "armgen: unknown command %q\n\n", name)
+		usage()
+		os.Exit(2)
+	}
+
+	fs := cmd.flagSet()
+	err := cmd.run(fs, args)
+	if err == flag.ErrHelp {
+		return
+	} else if err == errBadFlags {
+		os.Exit(2)
+	}
+	var uerr usageError
+	if errors.As(err, &uerr) {
+		fmt.Fprintf(os.Stderr, "armgen %s: %v\n", cmd.name, err)
+		fs.Usage()
+		os.Exit(2)
+	} else if err != nil {
+		fmt.Fprintf(os.Stderr, "armgen %s: %v\n", cmd.name, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// An Exclude rules out the words whose bits under Mask equal Value.
+type Exclude struct {
+	Mask  uint32
+	Value uint32
+}
+
+// A Pattern matches the words whose bits under Mask equal Value, except
+// those ruled out by one of its Excludes.
+type Pattern struct {
+	Mask     uint32
+	Value    uint32
+	Excludes []Exclude
+}
+
+// ParseBits parses a string of 0, 1 and x of up to 32 characters, most
+// significant bit first, into a mask and value. The string is aligned so
+// that its last character is bit lo.
+func ParseBits(s string, lo int) (mask, value uint32) {
+	for i := 0; i < len(s); i++ {
+		bit := uint(lo + len(s) - 1 - i)
+		switch s[i] {
+		case '0':
+			mask |= 1 << bit
+		case '1':
+			mask |= 1 << bit
+			value |= 1 << bit
+		}
+	}
+	return mask, value
+}
+
+func (p Pattern) MatchFixed(w uint32) bool {
+	return w&p.Mask == p.Value
+}
+
+func (p Pattern) Excluded(w uint32) bool {
+	for _, e := range p.Excludes {
+		if w&e.Mask == e.Value {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Pattern) Match(w uint32) bool {
+	return p.MatchFixed(w) && !p.Excluded(w)
+}
+
+// Fixed returns the number of fixed bits in the pattern.
+func (p Pattern) Fixed() int {
+	return bits.OnesCount32(p.Mask)
+}
+
+func (p Pattern) String() string {
+	b := []byte(strings.Repeat("x", 32))
+	for i := 0; i < 32; i++ {
+		if p.Mask&(1<<uint(31-i)) != 0 {
+			b[i] = '0' + byte(p.Value>>uint(31-i)&1)
+		}
+	}
+	return string(b)
+}
+
+// constraintExclude converts a box constraint such as "!= 11111" into an
+// Exclude.
+func constraintExclude(b Box) (Exclude, bool) {
+	c := strings.ReplaceAll(b.Constraint, " ", "")
+	if !strings.HasPrefix(c, "!=") {
+		return Exclude{}, false
+	}
+	c = strings.TrimPrefix(c, "!=")
+	mask, value := ParseBits(c, b.HiBit-len(c)+1)
+	return Exclude{mask, value}, mask != 0
+}
+
+// EncodingPattern returns the pattern of an encoding of the iclass.
+func (ic IClass) EncodingPattern(e *Encoding) Pattern {
+	var p Pattern
+	p.Mask, p.Value = ParseBits(ic.Bits(e), 0)
+	boxes := ic.RegDiagram.Boxes
+	if e != nil {
+		boxes = append(boxes[:len(boxes):len(boxes)], e.Boxes...)
+	}
+	for _, b := range boxes {
+		if ex, ok := constraintExclude(b); ok {
+			p.Excludes = append(p.Excludes, ex)
+		}
+	}
+	return p
+}
+
+// ParseDiagram parses the string form of a RegDiagram, such as
+// "sf=x|op=0|Rn!=11111|100010", into a pattern. Constraint boxes become
+// Excludes.
+func ParseDiagram(s string) (Pattern, error) {
+	var p Pattern
+	lo := 0
+	parts := strings.Split(s, "|")
+	for i := len(parts) - 1; i >= 0; i-- {
+		part := parts[i]
+		if part == "" {
+			continue
+		}
+		if _, bits, ok := strings.Cut(part, "!="); ok {
+			mask, value := ParseBits(bits, lo)
+			p.Excludes = append(p.Excludes, Exclude{mask, value})
+			lo += len(bits)
+			continue
+		}
+		bits := part
+		if _, b, ok := strings.Cut(part, "="); ok {
+			bits = b
+		}
+		mask, value := ParseBits(bits, lo)
+		p.Mask |= mask
+		p.Value |= value
+		lo += len(bits)
+	}
+	if lo != 32 {
+		return Pattern{}, fmt.Errorf("diagram %q has %d bits", s, lo)
+	}
+	return p, nil
+}
+
+// A Field is a named bit range of an instruction word.
+type Field struct {
+	Name  string
+	HiBit int
+	Width int
+}
+
+func (f Field) Extract(w uint32) uint32 {
+	lo := uint(f.HiBit - f.Width + 1)
+	return (w >> lo) & (1<<uint(f.Width) - 1)
+}
+
+func (ic IClass) Fields() []Field {
+	var fields []Field
+	for _, b := range ic.RegDiagram.Boxes {
+		if b.Name != "" {
+			fields = append(fields, Field{b.Name, b.HiBit, b.Size()})
+		}
+	}
+	return fields
+}
+
+// A Matcher matches the words of one encoding in the spec.
+type Matcher struct {
+	File     string
+	Insn     *InsnSection
+	IClass   *IClass
+	Encoding *Encoding
+	Pattern  Pattern
+}
+
+func (m *Matcher) Alias() bool {
+	return m.Insn.Type == "alias"
+}
+
+func (m *Matcher) Mnemonic() string {
+	if a := m.Encoding.Docs.AliasMnemonic(); a != "" {
+		return a
+	}
+	if mn := m.Encoding.Docs.Mnemonic(); mn != "" {
+		return mn
+	}
+	return m.Insn.Docs.Mnemonic()
+}
+
+// NewMatchers returns a matcher for every encoding in the spec.
+func NewMatchers(spec *Spec) []*Matcher {
+	var ms []*Matcher
+	for i := range spec.Files {
+		f := &spec.Files[i]
+		for j := range f.Insn.Classes.IClass {
+			c := &f.Insn.Classes.IClass[j]
+			for k := range c.Encodings {
+				e := &c.Encodings[k]
+				ms = append(ms, &Matcher{
+					File:     f.Name,
+					Insn:     &f.Insn,
+					IClass:   c,
+					Encoding: e,
+					Pattern:  c.EncodingPattern(e),
+				})
+			}
+		}
+	}
+	return ms
+}
+
+// A Decoding is the result of matching a word against the spec.
+type Decoding struct {
+	Word uint32
+	// Matches holds every encoding that matches the word, best first:
+	// instructions before aliases, then the most specific pattern.
+	Matches []*Matcher
+	// Conflicts holds the encodings whose fixed bits match but which are
+	// ruled out by a constraint.
+	Conflicts []*Matcher
+}
+
+func (d *Decoding) Best() *Matcher {
+	if len(d.Matches) == 0 {
+		return nil
+	}
+	return d.Matches[0]
+}
+
+// A Decoder indexes matchers by the top byte of the word they can match.
+type Decoder struct {
+	buckets [256][]*Matcher
+}
+
+func NewDecoder(ms []*Matcher) *Decoder {
+	d := &Decoder{}
+	for b := range d.buckets {
+		top := uint32(b) << 24
+		for _, m := range ms {
+			if top&m.Pattern.Mask&0xff000000 == m.Pattern.Value&0xff000000 {
+				d.buckets[b] = append(d.buckets[b], m)
+			}
+		}
+	}
+	return d
+}
+
+func (d *Decoder) Decode(w uint32) *Decoding {
+	return Decode(d.buckets[w>>24], w)
+}
+
+func Decode(ms []*Matcher, w uint32) *Decoding {
+	d := &Decoding{Word: w}
+	for _, m := range ms {
+		if !m.Pattern.MatchFixed(w) {
+			continue
+		}
+		if m.Pattern.Excluded(w) {
+			d.Conflicts = append(d.Conflicts, m)
+		} else {
+			d.Matches = append(d.Matches, m)
+		}
+	}
+	sort.SliceStable(d.Matches, func(i, j int) bool {
+		a, b := d.Matches[i], d.Matches[j]
+		if a.Alias() != b.Alias() {
+			return !a.Alias()
+		}
+		return a.Pattern.Fixed() > b.Pattern.Fixed()
+	})
+	return d
+}
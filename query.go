@@ -0,0 +1,361 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A Subject is the instruction a query expression is evaluated against.
+type Subject struct {
+	File  string
+	Insn  InsnSection
+	Model *FeatureModel
+}
+
+type Expr interface {
+	Eval(s *Subject) bool
+}
+
+type andExpr struct{ l, r Expr }
+type orExpr struct{ l, r Expr }
+type notExpr struct{ e Expr }
+
+func (e andExpr) Eval(s *Subject) bool { return e.l.Eval(s) && e.r.Eval(s) }
+func (e orExpr) Eval(s *Subject) bool  { return e.l.Eval(s) || e.r.Eval(s) }
+func (e notExpr) Eval(s *Subject) bool { return !e.e.Eval(s) }
+
+type predExpr struct {
+	fn func(s *Subject) bool
+}
+
+func (e predExpr) Eval(s *Subject) bool { return e.fn(s) }
+
+type cmpExpr struct {
+	field func(s *Subject) []string
+	op    string
+	val   string
+	rx    *regexp.Regexp
+}
+
+func (e cmpExpr) Eval(s *Subject) bool {
+	for _, v := range e.field(s) {
+		switch e.op {
+		case "==", "!=":
+			if strings.EqualFold(v, e.val) {
+				return e.op == "=="
+			}
+		case "=~", "!~":
+			if e.rx.MatchString(v) {
+				return e.op == "=~"
+			}
+		}
+	}
+	return e.op == "!=" || e.op == "!~"
+}
+
+var queryFields = map[string]func(s *Subject) []string{
+	"class":    func(s *Subject) []string { return s.Insn.GetClasses() },
+	"feature":  func(s *Subject) []string { return s.Insn.Features() },
+	"variant":  func(s *Subject) []string { return s.Insn.Variants() },
+	"mnemonic": func(s *Subject) []string { return s.Insn.Names() },
+	"file":     func(s *Subject) []string { return []string{s.File} },
+	"type":     func(s *Subject) []string { return []string{s.Insn.Type} },
+	"iclass":   func(s *Subject) []string { return s.Insn.IClassIds() },
+	"version":  func(s *Subject) []string { return []string{s.Model.MinVersionOf(s.Insn).String()} },
+}
+
+var queryPreds = map[string]func(s *Subject) bool{
+	"branch":     func(s *Subject) bool { return s.Insn.IsBranch() },
+	"branches":   func(s *Subject) bool { return s.Insn.IsBranch() },
+	"reads_mem":  func(s *Subject) bool { return s.Insn.ReadsMem() },
+	"writes_mem": func(s *Subject) bool { return s.Insn.WritesMem() },
+	"atomic":     func(s *Subject) bool { return s.Insn.MemAtomic() },
+	"uses_pc":    func(s *Subject) bool { return s.Insn.UsesPc() },
+	"base":       func(s *Subject) bool { return s.Insn.BaseVariant() },
+	"alias":      func(s *Subject) bool { return s.Insn.Type == "alias" },
+	"nomodify":   func(s *Subject) bool { return len(s.Insn.WriteSet()) == 0 },
+	"reads":      func(s *Subject) bool { return len(s.Insn.ReadSet()) != 0 },
+	"writes":     func(s *Subject) bool { return len(s.Insn.WriteSet()) != 0 },
+}
+
+var queryFuncs = map[string]func(arg string) (func(s *Subject) bool, error){
+	"reads": func(arg string) (func(s *Subject) bool, error) {
+		match, err := regMatcher(arg)
+		if err != nil {
+			return nil, err
+		}
+		return func(s *Subject) bool { return match(s.Insn.ReadSet()) }, nil
+	},
+	"writes": func(arg string) (func(s *Subject) bool, error) {
+		match, err := regMatcher(arg)
+		if err != nil {
+			return nil, err
+		}
+		return func(s *Subject) bool { return match(s.Insn.WriteSet()) }, nil
+	},
+	"uses": func(arg string) (func(s *Subject) bool, error) {
+		return func(s *Subject) bool { return s.Insn.Uses(arg) }, nil
+	},
+}
+
+var (
+	regrx   = regexp.MustCompile(`X\[\s*(\w+)`)
+	indexrx = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+	asmrx   = regexp.MustCompile(`^[WX]([a-z][a-z0-9]*)$`)
+)
+
+// regMatcher returns a function reporting whether a list of X[...] accesses
+// includes the register reg: X for any general-purpose register, an index
+// variable such as t (as in "X[t, 64]"), or an assembler operand such as Wt or
+// Xt, which names the same register at either width. Only the general-purpose
+// registers are tracked, so any other name is an error rather than never
+// matching.
+func regMatcher(reg string) (func(accesses []string) bool, error) {
+	if reg == "X" {
+		return func(accesses []string) bool { return len(accesses) != 0 }, nil
+	}
+	if m := asmrx.FindStringSubmatch(reg); m != nil {
+		reg = m[1]
+	}
+	if !indexrx.MatchString(reg) {
+		return nil, fmt.Errorf("unknown register %q: want X, an index variable such as t, or Wt or Xt", reg)
+	}
+	return func(accesses []string) bool { return hasReg(accesses, reg) }, nil
+}
+
+// hasReg reports whether any of the given X[...] accesses uses the register
+// index variable reg (for example "t" in "X[t, 64]").
+func hasReg(accesses []string, reg string) bool {
+	for _, a := range accesses {
+		for _, m := range regrx.FindAllStringSubmatch(a, -1) {
+			if m[1] == reg {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type token struct {
+	kind string // "ident", "string", or the operator itself
+	text string
+	pos  int
+}
+
+func lexQuery(src string) ([]token, error) {
+	var toks []token
+	isIdent := func(c rune) bool {
+		return c == '_' || c == '.' || c == '-' || unicode.IsLetter(c) || unicode.IsDigit(c)
+	}
+	i := 0
+	for i < len(src) {
+		c, size := utf8.DecodeRuneInString(src[i:])
+		switch {
+		case unicode.IsSpace(c):
+			i += size
+		case c == '"':
+			j := i + 1
+			for j < len(src) && src[j] != '"' {
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			s, err := strconv.Unquote(src[i : j+1])
+			if err != nil {
+				return nil, fmt.Errorf("bad string at %d: %w", i, err)
+			}
+			toks = append(toks, token{"string", s, i})
+			i = j + 1
+		case c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c):
+			j := i
+			for j < len(src) {
+				c, size := utf8.DecodeRuneInString(src[j:])
+				if !isIdent(c) {
+					break
+				}
+				j += size
+			}
+			toks = append(toks, token{"ident", src[i:j], i})
+			i = j
+		default:
+			op := ""
+			for _, o := range []string{"&&", "||", "==", "!=", "=~", "!~", "!", "(", ")", ","} {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected %q at %d", c, i)
+			}
+			toks = append(toks, token{op, op, i})
+			i += len(op)
+		}
+	}
+	return toks, nil
+}
+
+type queryParser struct {
+	toks []token
+	pos  int
+}
+
+func (p *queryParser) peek() token {
+	if p.pos >= len(p.toks) {
+		return token{kind: "eof", pos: -1}
+	}
+	return p.toks[p.pos]
+}
+
+func (p *queryParser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *queryParser) errorf(t token, format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	if t.kind == "eof" {
+		return fmt.Errorf("%s at end of query", msg)
+	}
+	return fmt.Errorf("%s at %d", msg, t.pos)
+}
+
+func (p *queryParser) keyword(t token, kw string) bool {
+	return t.kind == "ident" && strings.EqualFold(t.text, kw)
+}
+
+func (p *queryParser) parseOr() (Expr, error) {
+	l, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.kind == "||" || p.keyword(t, "or"); t = p.peek() {
+		p.next()
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l = orExpr{l, r}
+	}
+	return l, nil
+}
+
+func (p *queryParser) parseAnd() (Expr, error) {
+	l, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.kind == "&&" || p.keyword(t, "and"); t = p.peek() {
+		p.next()
+		r, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l = andExpr{l, r}
+	}
+	return l, nil
+}
+
+func (p *queryParser) parseUnary() (Expr, error) {
+	if t := p.peek(); t.kind == "!" || p.keyword(t, "not") {
+		p.next()
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{e}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (Expr, error) {
+	t := p.next()
+	switch t.kind {
+	case "(":
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if c := p.next(); c.kind != ")" {
+			return nil, p.errorf(c, "expected )")
+		}
+		return e, nil
+	case "ident":
+	default:
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+
+	name := strings.ToLower(t.text)
+	switch op := p.peek(); op.kind {
+	case "(":
+		p.next()
+		arg := p.next()
+		if arg.kind != "ident" && arg.kind != "string" {
+			return nil, p.errorf(arg, "expected argument to %s", name)
+		}
+		if c := p.next(); c.kind != ")" {
+			return nil, p.errorf(c, "expected )")
+		}
+		if fn, ok := queryFuncs[name]; ok {
+			pred, err := fn(arg.text)
+			if err != nil {
+				return nil, p.errorf(arg, "%v", err)
+			}
+			return predExpr{pred}, nil
+		}
+		if field, ok := queryFields[name]; ok {
+			return cmpExpr{field: field, op: "==", val: arg.text}, nil
+		}
+		return nil, p.errorf(t, "unknown function %q", t.text)
+	case "==", "!=", "=~", "!~":
+		p.next()
+		field, ok := queryFields[name]
+		if !ok {
+			return nil, p.errorf(t, "unknown field %q", t.text)
+		}
+		val := p.next()
+		if val.kind != "ident" && val.kind != "string" {
+			return nil, p.errorf(val, "expected value after %s", op.kind)
+		}
+		e := cmpExpr{field: field, op: op.kind, val: val.text}
+		if op.kind == "=~" || op.kind == "!~" {
+			rx, err := regexp.Compile(val.text)
+			if err != nil {
+				return nil, p.errorf(val, "bad regexp: %v", err)
+			}
+			e.rx = rx
+		}
+		return e, nil
+	}
+
+	if pred, ok := queryPreds[name]; ok {
+		return predExpr{pred}, nil
+	}
+	return nil, p.errorf(t, "unknown predicate %q", t.text)
+}
+
+// ParseQuery parses a -where filter expression.
+func ParseQuery(src string) (Expr, error) {
+	toks, err := lexQuery(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &queryParser{toks: toks}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != "eof" {
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+	return e, nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/xml"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func testInsn(t *testing.T, mnemonic, class, feature, code string) InsnSection {
+	variants := ""
+	if feature != "" {
+		variants = `<arch_variants><arch_variant name="" feature="` + feature + `"/></arch_variants>`
+	}
+	src := `<instructionsection id="` + strings.ToLower(mnemonic) + `" type="instruction"><classes><iclass name="x" id="x">` +
+		`<docvars><docvar key="instr-class" value="` + class + `"/></docvars>` + variants +
+		`<encoding name="x"><docvars><docvar key="mnemonic" value="` + mnemonic + `"/></docvars></encoding>` +
+		`</iclass></classes><ps_section><ps name="x"><pstext>` + code + `</pstext></ps></ps_section></instructionsection>`
+	var is InsnSection
+	if err := xml.Unmarshal([]byte(src), &is); err != nil {
+		t.Fatal(err)
+	}
+	return is
+}
+
+func TestQuery(t *testing.T) {
+	const (
+		read  = `<a link="impl-aarch64.X.read.2">X</a>`
+		write = `<a link="impl-aarch64.X.write.2">X</a>`
+	)
+	subjects := []Subject{
+		{File: "ldadd.xml", Insn: testInsn(t, "LDADD", "general", "FEAT_LSE",
+			"data = "+read+"[s, datasize];\n"+write+"[t, regsize] = data;")},
+		{File: "add.xml", Insn: testInsn(t, "ADD", "general", "",
+			"operand1 = "+read+"[n, datasize];\n"+write+"[d, datasize] = result;")},
+		{File: "add_z.xml", Insn: testInsn(t, "ADD", "sve", "FEAT_SVE", "Z[d, VL] = result;")},
+	}
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`mnemonic == ADD || mnemonic == LDADD && class == sve`, "add.xml add_z.xml"},
+		{`(mnemonic == ADD || mnemonic == LDADD) && class == sve`, "add_z.xml"},
+		{`!class == sve`, "add.xml ldadd.xml"},
+		{`!(mnemonic == ADD) && writes(X)`, "ldadd.xml"},
+		{`not alias and feature("FEAT_SVE")`, "add_z.xml"},
+		{`mnemonic =~ "^LD"`, "ldadd.xml"},
+		{`mnemonic !~ "^LD"`, "add.xml add_z.xml"},
+		{`feature != FEAT_LSE`, "add.xml add_z.xml"},
+		{`reads(X)`, "add.xml ldadd.xml"},
+		{`writes(Wn)`, ""},
+		{`writes(Wt)`, "ldadd.xml"},
+		{`writes(Xd) || reads(s)`, "add.xml ldadd.xml"},
+	}
+	for _, tt := range tests {
+		e, err := ParseQuery(tt.query)
+		if err != nil {
+			t.Errorf("%s: %v", tt.query, err)
+			continue
+		}
+		var got []string
+		for i := range subjects {
+			if e.Eval(&subjects[i]) {
+				got = append(got, subjects[i].File)
+			}
+		}
+		sort.Strings(got)
+		if strings.Join(got, " ") != tt.want {
+			t.Errorf("%s matches %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestQueryErrors(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`writes(V0)`, `unknown register "V0": want X, an index variable such as t, or Wt or Xt at 7`},
+		{`reads(SP)`, `unknown register "SP": want X, an index variable such as t, or Wt or Xt at 6`},
+		{`class → sve`, `unexpected '→' at 6`},
+		{`mnemonic == "ADD`, `unterminated string at 12`},
+		{`class == sve &&`, `unexpected "" at end of query`},
+		{`(class == sve`, `expected ) at end of query`},
+		{`branch sve`, `unexpected "sve" at 7`},
+		{`frob`, `unknown predicate "frob" at 0`},
+		{`colour == red`, `unknown field "colour" at 0`},
+		{`mnemonic =~ "("`, "bad regexp: error parsing regexp: missing closing ): `(` at 12"},
+	}
+	for _, tt := range tests {
+		_, err := ParseQuery(tt.query)
+		if err == nil {
+			t.Errorf("%s: no error, want %q", tt.query, tt.want)
+		} else if err.Error() != tt.want {
+			t.Errorf("%s: error %q, want %q", tt.query, err, tt.want)
+		}
+	}
+}
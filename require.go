@@ -0,0 +1,70 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+var requireCmd = &command{
+	name:  "require",
+	args:  "-target TARGET [flags] SPECDIR BINARY...",
+	short: "check that AArch64 ELF binaries only use instructions available on a target",
+	run:   runRequire,
+}
+
+func runRequire(fs *flag.FlagSet, args []string) error {
+	target := fs.String("target", "", "target architecture, e.g. armv8.2-a+sve or cortex-a76+FEAT_LSE2")
+	strict := fs.Bool("strict", false, "also fail on unallocated words")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *target == "" {
+		return usagef("-target is required")
+	}
+	if fs.NArg() < 2 {
+		return usagef("expected a spec directory and at least one binary")
+	}
+	dir := fs.Arg(0)
+	model, err := LoadFeatureModel(dir)
+	if err != nil {
+		return err
+	}
+	fset, err := model.ParseTarget(*target)
+	if err != nil {
+		return usagef("-target: %v", err)
+	}
+	spec, err := LoadSpec(dir)
+	if err != nil {
+		return err
+	}
+	dec := NewDecoder(NewMatchers(spec))
+
+	offending := 0
+	for _, path := range fs.Args()[1:] {
+		err := scanELF(path, func(addr uint64, w uint32) {
+			d := dec.Decode(w)
+			if d.Best() == nil {
+				if *strict {
+					fmt.Printf("%s: %x:\t%08x\t(unallocated)\n", path, addr, w)
+					offending++
+				}
+				return
+			}
+			for _, m := range d.Matches {
+				if m.IClass.SupportedBy(fset) {
+					return
+				}
+			}
+			m := d.Best()
+			fmt.Printf("%s: %x:\t%08x\t%s\trequires %s\n", path, addr, w, m.Mnemonic(), iclassFeatures(m.IClass))
+			offending++
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if offending != 0 {
+		return fmt.Errorf("%d instructions are not supported by %s", offending, *target)
+	}
+	return nil
+}
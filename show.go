@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+var showCmd = &command{
+	name:  "show",
+	args:  "[flags] SPECDIR NAME...",
+	short: "show the details of instructions by mnemonic, file, encoding, iclass or section id",
+	run:   runShow,
+}
+
+// matches reports whether name refers to the file: it may be a mnemonic, the
+// file name with or without .xml, the section id, an encoding name, an iclass
+// id on its own or qualified by the file as FILE/ICLASS, or a record ID.
+func (f SpecFile) matches(name string) bool {
+	if strings.EqualFold(f.Name, name) || strings.EqualFold(strings.TrimSuffix(f.Name, ".xml"), name) {
+		return true
+	}
+	file, iclass, qualified := strings.Cut(name, "/")
+	if qualified && !strings.EqualFold(f.Name, file) && !strings.EqualFold(strings.TrimSuffix(f.Name, ".xml"), file) {
+		return false
+	}
+	if !qualified {
+		iclass = name
+	}
+	for _, c := range f.Insn.Classes.IClass {
+		if strings.EqualFold(c.Id, iclass) || strings.EqualFold(RecordID(f.Name, c), name) {
+			return true
+		}
+	}
+	if qualified {
+		return false
+	}
+	if strings.EqualFold(f.Insn.Id, name) {
+		return true
+	}
+	for _, n := range f.Insn.Names() {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	for _, c := range f.Insn.Classes.IClass {
+		for _, e := range c.Encodings {
+			if strings.EqualFold(e.Name, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func runShow(fs *flag.FlagSet, args []string) error {
+	noCode := fs.Bool("nocode", false, "do not show pseudocode")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() < 2 {
+		return usagef("expected a spec directory and at least one name")
+	}
+	dir := fs.Arg(0)
+	model, err := LoadFeatureModel(dir)
+	if err != nil {
+		return err
+	}
+	spec, err := LoadSpec(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range fs.Args()[1:] {
+		found := false
+		for _, f := range spec.Files {
+			if f.matches(name) {
+				found = true
+				writeInsn(os.Stdout, f, model, !*noCode)
+			}
+		}
+		if !found {
+			return fmt.Errorf("no instruction matches %q", name)
+		}
+	}
+	return nil
+}
+
+// writeDiagram prints the iclass diagram as a table of bit ranges, field
+// names and bit values.
+func writeDiagram(w io.Writer, indent string, boxes []Box) {
+	buf := &bytes.Buffer{}
+	tw := tabwriter.NewWriter(buf, 0, 0, 1, ' ', tabwriter.Debug)
+	var hi, names, bits []string
+	for _, b := range boxes {
+		if b.Size() == 1 {
+			hi = append(hi, fmt.Sprint(b.HiBit))
+		} else {
+			hi = append(hi, fmt.Sprintf("%d:%d", b.HiBit, b.HiBit-b.Size()+1))
+		}
+		names = append(names, b.Name)
+		if b.Constraint != "" {
+			bits = append(bits, strings.ReplaceAll(b.Constraint, " ", ""))
+		} else {
+			bits = append(bits, b.Pattern())
+		}
+	}
+	for _, row := range [][]string{hi, names, bits} {
+		fmt.Fprintf(tw, "%s\t\n", strings.Join(row, "\t"))
+	}
+	tw.Flush()
+	for _, l := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		fmt.Fprintf(w, "%s%s\n", indent, l)
+	}
+}
+
+func writeCode(w io.Writer, title, code string) {
+	code = strings.TrimSpace(stripMarkup(code))
+	if code == "" {
+		return
+	}
+	fmt.Fprintf(w, "\t%s:\n", title)
+	for _, l := range strings.Split(code, "\n") {
+		fmt.Fprintf(w, "\t\t%s\n", l)
+	}
+}
+
+func writeInsn(w io.Writer, f SpecFile, model *FeatureModel, code bool) {
+	insn := f.Insn
+	fmt.Fprintf(w, "%s: %s (%s, %s)\n", f.Name, strings.Join(insn.Names(), ", "), insn.Type, strings.Join(insn.GetClasses(), ";"))
+	fmt.Fprintf(w, "\tmin version: %s\n", model.MinVersionOf(insn))
+	if effects := insn.Effects(); len(effects) != 0 {
+		fmt.Fprintf(w, "\teffects: %s\n", strings.Join(effects, ", "))
+	}
+	for _, r := range insn.ReadSet() {
+		fmt.Fprintf(w, "\treads: %s\n", strings.TrimSuffix(r, ";"))
+	}
+	for _, r := range insn.WriteSet() {
+		fmt.Fprintf(w, "\twrites: %s\n", r)
+	}
+
+	for _, c := range insn.Classes.IClass {
+		fmt.Fprintf(w, "\n\t%s: %s\n", c.Id, c.Name)
+		if vs := variantString(c.ArchVariants); vs != "" {
+			fmt.Fprintf(w, "\t\tvariants: %s\n", vs)
+		}
+		writeDiagram(w, "\t\t", c.RegDiagram.Boxes)
+		for i := range c.Encodings {
+			e := &c.Encodings[i]
+			fmt.Fprintf(w, "\t\t%s: %s\n", e.Name, c.Bits(e))
+			fmt.Fprintf(w, "\t\t\t%s\n", e.Asm())
+		}
+		if code {
+			writeCode(w, "decode", c.Code.Ps.PsText.Content)
+		}
+	}
+	if code {
+		fmt.Fprintln(w)
+		writeCode(w, "operation", insn.Code.Ps.PsText.Content)
+	}
+	fmt.Fprintln(w)
+}
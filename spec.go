@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/xml"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type SpecFile struct {
+	Name string
+	Insn InsnSection
+}
+
+// A Spec is the set of instruction and alias sections loaded from a spec
+// directory, sorted by file name.
+type Spec struct {
+	Dir   string
+	Files []SpecFile
+}
+
+func parseSpecFile(path string) (*SpecFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var insn InsnSection
+	if err := xml.Unmarshal(data, &insn); err != nil {
+		return nil, nil
+	}
+	if insn.Type != "instruction" && insn.Type != "alias" {
+		return nil, nil
+	}
+	return &SpecFile{
+		Name: filepath.Base(path),
+		Insn: insn,
+	}, nil
+}
+
+// ParseSpec parses every instruction and alias section in dir, using one
+// worker per CPU.
+func ParseSpec(dir string) (*Spec, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.HasSuffix(path, ".xml") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*SpecFile, len(paths))
+	errs := make([]error, len(paths))
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for t := 0; t < runtime.NumCPU(); t++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				files[i], errs[i] = parseSpecFile(paths[i])
+			}
+		}()
+	}
+	for i := range paths {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	spec := &Spec{Dir: dir}
+	for i, f := range files {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if f != nil {
+			spec.Files = append(spec.Files, *f)
+		}
+	}
+	// Paths are visited in lexical order, so a stable sort keeps files with
+	// the same name in different directories in a deterministic order.
+	sort.SliceStable(spec.Files, func(i, j int) bool {
+		return spec.Files[i].Name < spec.Files[j].Name
+	})
+	return spec, nil
+}
+
+func (s *Spec) Lookup(name string) (InsnSection, bool) {
+	i := sort.Search(len(s.Files), func(i int) bool {
+		return s.Files[i].Name >= name
+	})
+	if i < len(s.Files) && s.Files[i].Name == name {
+		return s.Files[i].Insn, true
+	}
+	return InsnSection{}, false
+}
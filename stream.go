@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// wordInputs are the encodings of the word streams classify -words reads.
+var wordInputs = []string{"hex", "le", "be"}
+
+func validInput(input string) bool {
+	for _, in := range wordInputs {
+		if in == input {
+			return true
+		}
+	}
+	return false
+}
+
+// scanWords calls fn with each word of r, which holds hex words as accepted
+// by readHexWords or raw 32-bit words in the given byte order.
+func scanWords(r io.Reader, input string, fn func(uint32) error) error {
+	var order binary.ByteOrder
+	switch input {
+	case "hex":
+		return scanHexWords(r, fn)
+	case "le":
+		order = binary.LittleEndian
+	case "be":
+		order = binary.BigEndian
+	default:
+		return fmt.Errorf("unknown input %q", input)
+	}
+	br := bufio.NewReader(r)
+	var b [4]byte
+	for {
+		_, err := io.ReadFull(br, b[:])
+		if err == io.EOF {
+			return nil
+		} else if err == io.ErrUnexpectedEOF {
+			return fmt.Errorf("stream ends with a partial word")
+		} else if err != nil {
+			return err
+		}
+		if err := fn(order.Uint32(b[:])); err != nil {
+			return err
+		}
+	}
+}
+
+// A WordClass is the classification of one word of a stream.
+type WordClass struct {
+	Word       string
+	ID         string `json:",omitempty"`
+	IClass     string `json:",omitempty"`
+	InstrClass string `json:",omitempty"`
+	Features   string `json:",omitempty"`
+}
+
+func newWordClass(records []Record, w uint32, v int32) WordClass {
+	wc := WordClass{Word: fmt.Sprintf("%08x", w)}
+	if v >= 0 {
+		r := &records[v]
+		wc.ID, wc.IClass, wc.InstrClass, wc.Features = r.ID, r.IClass, r.InstrClass, r.Features
+	}
+	return wc
+}
+
+// classifyStreams classifies every word of the named files, or of stdin if
+// there are none, writing one line per word as text or JSONL.
+func classifyStreams(w io.Writer, records []Record, paths []string, input, format string) error {
+	c, err := NewClassifier(records)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	emit := func(word uint32) error {
+		wc := newWordClass(records, word, c.Classify(word))
+		switch {
+		case format == "jsonl":
+			return enc.Encode(wc)
+		case wc.ID == "":
+			_, err := fmt.Fprintf(bw, "%s unallocated\n", wc.Word)
+			return err
+		default:
+			_, err := fmt.Fprintf(bw, "%s %s %s %s %s\n", wc.Word, wc.ID, wc.IClass, wc.InstrClass, orBase(wc.Features))
+			return err
+		}
+	}
+	if len(paths) == 0 {
+		if err := scanWords(os.Stdin, input, emit); err != nil {
+			return err
+		}
+	}
+	for _, p := range paths {
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		err = scanWords(f, input, emit)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+	}
+	return bw.Flush()
+}
+
+func orBase(features string) string {
+	if features == "" {
+		return "base"
+	}
+	return features
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// The sweep classifies the word space in shards of ShardPages pages. Each
+// finished shard is written to its own file in the shard directory, so an
+// interrupted sweep resumes from the shards that are already on disk. A
+// shard file holds its pages in order, each as a uvarint length followed by
+// the run-length encoded page.
+const (
+	ShardPages = 256
+	ShardCount = PageCount / ShardPages
+	ShardWords = ShardPages * PageSize
+)
+
+func shardPath(dir string, s int) string {
+	return filepath.Join(dir, fmt.Sprintf("shard-%04d", s))
+}
+
+// checkManifest makes sure the shards in dir were computed from records,
+// creating the directory and its manifest if needed.
+func checkManifest(dir string, records []Record) error {
+	hash := RecordsHash(records)
+	want := fmt.Sprintf("%s %d\n", hex.EncodeToString(hash[:]), len(records))
+	path := filepath.Join(dir, "manifest")
+	got, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+		return os.WriteFile(path, []byte(want), 0o644)
+	} else if err != nil {
+		return err
+	}
+	if string(got) != want {
+		return fmt.Errorf("%s holds shards for different records; remove it to start over", dir)
+	}
+	return nil
+}
+
+func sweepShard(dir string, s int, classify func(base uint32, vals []int32), done *uint64) error {
+	path := shardPath(dir, s)
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	vals := make([]int32, PageSize)
+	var buf []byte
+	for p := s * ShardPages; p < (s+1)*ShardPages; p++ {
+		classify(uint32(p)<<PageBits, vals)
+		buf = appendPage(buf[:0], vals)
+		var n [binary.MaxVarintLen64]byte
+		w.Write(n[:binary.PutUvarint(n[:], uint64(len(buf)))])
+		w.Write(buf)
+		atomic.AddUint64(done, PageSize)
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// progress reports the progress of the sweep on stderr until stop is closed.
+func progress(done *uint64, resumed uint64, start time.Time, stop chan struct{}) {
+	const total = uint64(1) << 32
+	t := time.NewTicker(5 * time.Second)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			fmt.Fprintln(os.Stderr)
+			return
+		case <-t.C:
+		}
+		n := atomic.LoadUint64(done)
+		elapsed := time.Since(start)
+		eta := "unknown"
+		if n > resumed {
+			rate := float64(n-resumed) / elapsed.Seconds()
+			eta = (time.Duration(float64(total-n)/rate) * time.Second).Round(time.Second).String()
+		}
+		fmt.Fprintf(os.Stderr, "\r%5.1f%% of the word space classified, %s left  ", float64(n)/float64(total)*100, eta)
+	}
+}
+
+// Sweep classifies every word into shards in dir using one worker per CPU,
+// skipping the shards left by a previous run. classify fills vals with the
+// classification of the page of words starting at base.
+func Sweep(dir string, records []Record, classify func(base uint32, vals []int32)) error {
+	if err := checkManifest(dir, records); err != nil {
+		return err
+	}
+	var todo []int
+	for s := 0; s < ShardCount; s++ {
+		if _, err := os.Stat(shardPath(dir, s)); err != nil {
+			todo = append(todo, s)
+		}
+	}
+	resumed := uint64(ShardCount-len(todo)) * ShardWords
+	if resumed != 0 {
+		fmt.Fprintf(os.Stderr, "resuming: %d of %d shards already done\n", ShardCount-len(todo), ShardCount)
+	}
+	done := resumed
+	stop := make(chan struct{})
+	go progress(&done, resumed, time.Now(), stop)
+	defer close(stop)
+
+	work := make(chan int)
+	errs := make(chan error, runtime.NumCPU())
+	var wg sync.WaitGroup
+	for t := 0; t < runtime.NumCPU(); t++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for s := range work {
+				if err := sweepShard(dir, s, classify, &done); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	var err error
+feed:
+	for _, s := range todo {
+		select {
+		case work <- s:
+		case err = <-errs:
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+	if err == nil {
+		select {
+		case err = <-errs:
+		default:
+		}
+	}
+	return err
+}
+
+// MergeShards writes the table at path from the shards in dir.
+func MergeShards(dir, path string, records []Record) error {
+	if err := checkManifest(dir, records); err != nil {
+		return err
+	}
+	tw, err := CreateTable(path, records)
+	if err != nil {
+		return err
+	}
+	vals := make([]int32, PageSize)
+	for s := 0; s < ShardCount; s++ {
+		f, err := os.Open(shardPath(dir, s))
+		if err != nil {
+			tw.Close()
+			return err
+		}
+		r := bufio.NewReader(f)
+		for p := 0; p < ShardPages; p++ {
+			err := readShardPage(r, vals)
+			if err == nil {
+				err = tw.WritePage(vals)
+			}
+			if err != nil {
+				f.Close()
+				tw.Close()
+				return fmt.Errorf("%s: page %d: %w", f.Name(), p, err)
+			}
+		}
+		f.Close()
+	}
+	return tw.Close()
+}
+
+func readShardPage(r *bufio.Reader, vals []int32) error {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return err
+	}
+	_, err = decodePage(b, vals)
+	return err
+}
+
+// removeShards removes the shard directory after a successful merge.
+func removeShards(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.Name() != "manifest" && !strings.HasPrefix(e.Name(), "shard-") {
+			return fmt.Errorf("%s contains %s; not removing it", dir, e.Name())
+		}
+	}
+	return os.RemoveAll(dir)
+}
@@ -0,0 +1,317 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// A classification table maps every 32-bit word to the index of the record
+// that matches it, or -1. The file starts with a header:
+//
+//	magic     [8]byte  "ARMGENTB"
+//	version   uint32
+//	records   uint32   number of records the table was built from
+//	hash      [32]byte RecordsHash of those records
+//	directory uint64   offset of the page directory
+//
+// followed by the pages and then the directory. The word space is split into
+// 65536 pages of 65536 words indexed by the top 16 bits of the word. Each
+// directory entry is the page's offset, its encoded size and a value: pages
+// whose words all map to the same value have size 0 and are not stored at
+// all, and the others are stored as runs of (uvarint length, varint value).
+// All integers are little endian.
+
+const (
+	tableMagic   = "ARMGENTB"
+	tableVersion = 1
+
+	PageBits  = 16
+	PageSize  = 1 << PageBits
+	PageCount = 1 << (32 - PageBits)
+
+	tableHeaderSize = 8 + 4 + 4 + 32 + 8
+	tableEntrySize  = 8 + 4 + 4
+)
+
+// RecordsHash identifies the records a table was built from by the parts of
+// each record that determine the classification.
+func RecordsHash(records []Record) [32]byte {
+	h := sha256.New()
+	for _, r := range records {
+		fmt.Fprintf(h, "%s\x00%s\x00", r.ID, r.RegDiagram)
+	}
+	var sum [32]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+type TableHeader struct {
+	Version uint32
+	Records uint32
+	Hash    [32]byte
+}
+
+type tableEntry struct {
+	Offset uint64
+	Size   uint32
+	Value  int32
+}
+
+// appendPage appends the run-length encoding of a page to b.
+func appendPage(b []byte, vals []int32) []byte {
+	for i := 0; i < len(vals); {
+		j := i + 1
+		for j < len(vals) && vals[j] == vals[i] {
+			j++
+		}
+		b = binary.AppendUvarint(b, uint64(j-i))
+		b = binary.AppendVarint(b, int64(vals[i]))
+		i = j
+	}
+	return b
+}
+
+// decodePage decodes a run-length encoded page from b into vals and returns
+// the number of bytes consumed.
+func decodePage(b []byte, vals []int32) (int, error) {
+	n := 0
+	for i := 0; i < len(vals); {
+		run, k := binary.Uvarint(b[n:])
+		if k <= 0 {
+			return 0, errors.New("bad run length")
+		}
+		n += k
+		v, k := binary.Varint(b[n:])
+		if k <= 0 {
+			return 0, errors.New("bad run value")
+		}
+		n += k
+		if run == 0 || run > uint64(len(vals)-i) {
+			return 0, fmt.Errorf("run of %d overflows the page", run)
+		}
+		for end := i + int(run); i < end; i++ {
+			vals[i] = int32(v)
+		}
+	}
+	return n, nil
+}
+
+// A TableWriter writes a classification table one page at a time, in order.
+type TableWriter struct {
+	f      *os.File
+	w      *bufio.Writer
+	off    uint64
+	header TableHeader
+	dir    []tableEntry
+	buf    []byte
+}
+
+func CreateTable(path string, records []Record) (*TableWriter, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	tw := &TableWriter{
+		f:      f,
+		w:      bufio.NewWriter(f),
+		off:    tableHeaderSize,
+		header: TableHeader{Version: tableVersion, Records: uint32(len(records)), Hash: RecordsHash(records)},
+	}
+	// The header is rewritten with the directory offset by Close.
+	if err := tw.writeHeader(tw.w, 0); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return tw, nil
+}
+
+func (tw *TableWriter) writeHeader(w io.Writer, dir uint64) error {
+	b := make([]byte, 0, tableHeaderSize)
+	b = append(b, tableMagic...)
+	b = binary.LittleEndian.AppendUint32(b, tw.header.Version)
+	b = binary.LittleEndian.AppendUint32(b, tw.header.Records)
+	b = append(b, tw.header.Hash[:]...)
+	b = binary.LittleEndian.AppendUint64(b, dir)
+	_, err := w.Write(b)
+	return err
+}
+
+// WritePage appends the next page of the table.
+func (tw *TableWriter) WritePage(vals []int32) error {
+	if len(vals) != PageSize {
+		return fmt.Errorf("page has %d values, want %d", len(vals), PageSize)
+	}
+	if len(tw.dir) == PageCount {
+		return errors.New("table is full")
+	}
+	uniform := true
+	for _, v := range vals {
+		if v != vals[0] {
+			uniform = false
+			break
+		}
+	}
+	if uniform {
+		tw.dir = append(tw.dir, tableEntry{Offset: tw.off, Value: vals[0]})
+		return nil
+	}
+	b := appendPage(tw.buf[:0], vals)
+	tw.buf = b
+	if _, err := tw.w.Write(b); err != nil {
+		return err
+	}
+	tw.dir = append(tw.dir, tableEntry{Offset: tw.off, Size: uint32(len(b))})
+	tw.off += uint64(len(b))
+	return nil
+}
+
+// Close writes the directory and the final header. Every page must have
+// been written.
+func (tw *TableWriter) Close() error {
+	if len(tw.dir) != PageCount {
+		tw.f.Close()
+		return fmt.Errorf("table has %d pages, want %d", len(tw.dir), PageCount)
+	}
+	b := make([]byte, 0, tableEntrySize)
+	for _, e := range tw.dir {
+		b = binary.LittleEndian.AppendUint64(b[:0], e.Offset)
+		b = binary.LittleEndian.AppendUint32(b, e.Size)
+		b = binary.LittleEndian.AppendUint32(b, uint32(e.Value))
+		if _, err := tw.w.Write(b); err != nil {
+			tw.f.Close()
+			return err
+		}
+	}
+	if err := tw.w.Flush(); err != nil {
+		tw.f.Close()
+		return err
+	}
+	if _, err := tw.f.Seek(0, io.SeekStart); err != nil {
+		tw.f.Close()
+		return err
+	}
+	if err := tw.writeHeader(tw.f, tw.off); err != nil {
+		tw.f.Close()
+		return err
+	}
+	return tw.f.Close()
+}
+
+// A Table reads a classification table lazily: only the header and the
+// directory are read when it is opened, and pages are decoded on demand.
+type Table struct {
+	TableHeader
+	r    io.ReaderAt
+	c    io.Closer
+	dir  []tableEntry
+	page int
+	vals []int32
+}
+
+func OpenTable(path string) (*Table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	t, err := NewTable(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	t.c = f
+	return t, nil
+}
+
+func NewTable(r io.ReaderAt) (*Table, error) {
+	h := make([]byte, tableHeaderSize)
+	if _, err := r.ReadAt(h, 0); err != nil {
+		return nil, err
+	}
+	if string(h[:8]) != tableMagic {
+		return nil, errors.New("not a classification table")
+	}
+	t := &Table{r: r, page: -1}
+	t.Version = binary.LittleEndian.Uint32(h[8:])
+	if t.Version != tableVersion {
+		return nil, fmt.Errorf("unsupported table version %d", t.Version)
+	}
+	t.Records = binary.LittleEndian.Uint32(h[12:])
+	copy(t.Hash[:], h[16:48])
+	off := binary.LittleEndian.Uint64(h[48:])
+
+	d := make([]byte, PageCount*tableEntrySize)
+	if _, err := r.ReadAt(d, int64(off)); err != nil {
+		return nil, fmt.Errorf("reading directory: %w", err)
+	}
+	t.dir = make([]tableEntry, PageCount)
+	for i := range t.dir {
+		e := d[i*tableEntrySize:]
+		t.dir[i] = tableEntry{
+			Offset: binary.LittleEndian.Uint64(e),
+			Size:   binary.LittleEndian.Uint32(e[8:]),
+			Value:  int32(binary.LittleEndian.Uint32(e[12:])),
+		}
+	}
+	return t, nil
+}
+
+func (t *Table) Close() error {
+	if t.c == nil {
+		return nil
+	}
+	return t.c.Close()
+}
+
+// Uniform reports whether every word of page p maps to the same value, and
+// that value.
+func (t *Table) Uniform(p int) (int32, bool) {
+	e := t.dir[p]
+	return e.Value, e.Size == 0
+}
+
+// Page returns the values of the words of page p. The slice is only valid
+// until the next call to Page or Lookup.
+func (t *Table) Page(p int) ([]int32, error) {
+	if p == t.page {
+		return t.vals, nil
+	}
+	if t.vals == nil {
+		t.vals = make([]int32, PageSize)
+	}
+	t.page = -1
+	e := t.dir[p]
+	if e.Size == 0 {
+		for i := range t.vals {
+			t.vals[i] = e.Value
+		}
+		t.page = p
+		return t.vals, nil
+	}
+	b := make([]byte, e.Size)
+	if _, err := t.r.ReadAt(b, int64(e.Offset)); err != nil {
+		return nil, err
+	}
+	if _, err := decodePage(b, t.vals); err != nil {
+		return nil, fmt.Errorf("page %d: %w", p, err)
+	}
+	t.page = p
+	return t.vals, nil
+}
+
+// Lookup returns the index of the record matching w, or -1.
+func (t *Table) Lookup(w uint32) (int32, error) {
+	p := int(w >> PageBits)
+	if v, ok := t.Uniform(p); ok {
+		return v, nil
+	}
+	vals, err := t.Page(p)
+	if err != nil {
+		return 0, err
+	}
+	return vals[w&(PageSize-1)], nil
+}